@@ -15,36 +15,391 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/rates": {
+        "/api/v1/admin/coins": {
             "get": {
-                "description": "Возвращает список всех доступных курсов криптовалют",
+                "description": "Возвращает все монеты каталога, включая отключенные",
                 "produces": [
+                    "application/json",
+                    "text/plain"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Получить весь каталог монет",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer-токен администратора (ADMIN_TOKEN)",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Каталог монет",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/server.CoinResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Неверный токен администратора",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Добавляет монету, цены которой начнут собираться со следующего цикла обновления",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/plain"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Добавить монету в каталог",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer-токен администратора (ADMIN_TOKEN)",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Монета: идентификатор CoinGecko, тикер и название",
+                        "name": "coin",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/server.coinRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Добавленная монета",
+                        "schema": {
+                            "$ref": "#/definitions/server.CoinResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные параметры запроса",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Неверный токен администратора",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/coins/{coin}/aliases": {
+            "post": {
+                "description": "Добавляет альтернативное название, по которому монету можно найти в запросах",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Добавить псевдоним монеты",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer-токен администратора (ADMIN_TOKEN)",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор монеты",
+                        "name": "coin",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Псевдоним",
+                        "name": "alias",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/server.aliasRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Псевдоним добавлен",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные параметры запроса",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Неверный токен администратора",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Монета не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/coins/{coin}/disable": {
+            "post": {
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Отключить монету",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer-токен администратора (ADMIN_TOKEN)",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор монеты",
+                        "name": "coin",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Монета отключена",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Неверный токен администратора",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Монета не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/coins/{coin}/enable": {
+            "post": {
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Включить монету",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer-токен администратора (ADMIN_TOKEN)",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор монеты",
+                        "name": "coin",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Монета включена",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Неверный токен администратора",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Монета не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/coins": {
+            "get": {
+                "description": "Возвращает монеты каталога, цены которых собираются",
+                "produces": [
+                    "application/json",
+                    "text/plain"
+                ],
+                "tags": [
+                    "coins"
+                ],
+                "summary": "Получить список отслеживаемых монет",
+                "responses": {
+                    "200": {
+                        "description": "Список монет",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/server.CoinResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/portfolio": {
+            "get": {
+                "description": "Возвращает позиции и итоги портфеля, оцененные по последним сохраненным курсам. Токен выдается командой /token в Telegram-боте",
+                "produces": [
+                    "application/json",
+                    "text/plain"
+                ],
+                "tags": [
+                    "portfolio"
+                ],
+                "summary": "Получить портфель пользователя",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer-токен портфеля",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Портфель",
+                        "schema": {
+                            "$ref": "#/definitions/server.PortfolioResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Неверный токен портфеля",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/rates": {
+            "get": {
+                "description": "Возвращает список всех доступных курсов криптовалют\nФормат ответа выбирается заголовком Accept: application/json или text/plain (по умолчанию)",
+                "produces": [
+                    "application/json",
                     "text/plain"
                 ],
                 "tags": [
                     "rates"
                 ],
                 "summary": "Получить все курсы валют",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "usd",
+                        "description": "Валюта котировки из настройки fetcher.quotes, например usd, eur, rub, btc",
+                        "name": "vs",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "Курсы валют",
                         "schema": {
-                            "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/server.RateResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неподдерживаемая валюта котировки",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Внутренняя ошибка сервера",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/server.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/rates/{currency}": {
+        "/api/v1/rates/{currency}": {
             "get": {
-                "description": "Возвращает детальную информацию по конкретной криптовалюте",
+                "description": "Возвращает детальную информацию по конкретной криптовалюте\nФормат ответа выбирается заголовком Accept: application/json или text/plain (по умолчанию)",
                 "produces": [
+                    "application/json",
                     "text/plain"
                 ],
                 "tags": [
@@ -54,33 +409,771 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Идентификатор валюты",
+                        "description": "Идентификатор, тикер или название валюты",
                         "name": "currency",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "usd",
+                        "description": "Валюта котировки из настройки fetcher.quotes, например usd, eur, rub, btc",
+                        "name": "vs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Показать котировки отдельных источников",
+                        "name": "breakdown",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "Данные по валюте",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/server.RateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неподдерживаемая валюта котировки",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Валюта не найдена",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/server.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Внутренняя ошибка сервера",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/rates/{currency}/candles": {
+            "get": {
+                "description": "Возвращает свечи open/high/low/close за период с заданным интервалом",
+                "produces": [
+                    "application/json",
+                    "text/plain"
+                ],
+                "tags": [
+                    "rates"
+                ],
+                "summary": "Получить свечи (OHLC) по валюте",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор, тикер или название валюты",
+                        "name": "currency",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "usd",
+                        "description": "Валюта котировки из настройки fetcher.quotes, например usd, eur, rub, btc",
+                        "name": "vs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "1h",
+                        "description": "Интервал свечи: 1m, 5m, 1h, 1d",
+                        "name": "interval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало периода (RFC3339 или unix-время)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Конец периода (RFC3339 или unix-время)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Свечи по валюте",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/server.CandleResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные параметры запроса",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Валюта не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/rates/{currency}/history": {
+            "get": {
+                "description": "Возвращает временной ряд сохраненных наблюдений цены. По умолчанию ряд прореживается до 500 точек (средняя, минимальная и максимальная цена в каждом шаге).\nС параметром step=raw возвращаются исходные наблюдения постранично: курсор следующей страницы передается в параметре cursor",
+                "produces": [
+                    "application/json",
+                    "text/plain"
+                ],
+                "tags": [
+                    "rates"
+                ],
+                "summary": "Получить историю цен по валюте",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор, тикер или название валюты",
+                        "name": "currency",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "usd",
+                        "description": "Валюта котировки из настройки fetcher.quotes, например usd, eur, rub, btc",
+                        "name": "vs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало периода (RFC3339 или unix-время), по умолчанию сутки назад",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Конец периода (RFC3339 или unix-время), по умолчанию текущее время",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Шаг ряда (5m, 1h или секунды) либо raw для исходных наблюдений",
+                        "name": "step",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Курсор страницы исходных наблюдений",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 500,
+                        "description": "Размер страницы исходных наблюдений (до 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "История цен: points для прореженного ряда, ticks и next_cursor для step=raw",
+                        "schema": {
+                            "$ref": "#/definitions/server.HistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные параметры запроса",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Валюта не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/status/providers": {
+            "get": {
+                "description": "Возвращает состояние circuit breaker каждого источника цен в порядке приоритета",
+                "produces": [
+                    "application/json",
+                    "text/plain"
+                ],
+                "tags": [
+                    "status"
+                ],
+                "summary": "Получить состояние источников цен",
+                "responses": {
+                    "200": {
+                        "description": "Состояние источников",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/server.ProviderStatusResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/stream": {
+            "get": {
+                "description": "Отправляет каждую сохраненную цену выбранных монет событием price с порядковым id и данными PriceUpdateResponse.\nПри переподключении клиент передает заголовок Last-Event-ID (или параметр last_event_id) и получает пропущенные события из буфера последних обновлений.\nКаждые 15 секунд отправляется комментарий-heartbeat, медленные клиенты отключаются",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "stream"
+                ],
+                "summary": "Поток цен через Server-Sent Events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Монеты через запятую: идентификатор, тикер или название. По умолчанию все",
+                        "name": "coins",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "usd",
+                        "description": "Валюта котировки из настройки fetcher.quotes, например usd, eur, rub, btc",
+                        "name": "vs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор последнего полученного события",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор последнего полученного события, если заголовок недоступен",
+                        "name": "last_event_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Поток событий price",
+                        "schema": {
+                            "$ref": "#/definitions/server.PriceUpdateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные параметры запроса",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Монета не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Превышено число подключений",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
                         }
                     }
                 }
             }
+        },
+        "/ws/rates": {
+            "get": {
+                "description": "Открывает WebSocket, в который отправляется каждая сохраненная цена монет из подписки: {\"type\": \"price\", \"price\": {...}}.\nПодписка меняется сообщениями {\"action\": \"subscribe\", \"coins\": [\"btc\"]} и {\"action\": \"unsubscribe\", \"coins\": [\"btc\"]}.\nСервер отправляет ping каждые 30 секунд, медленные клиенты отключаются с кодом 1013",
+                "tags": [
+                    "stream"
+                ],
+                "summary": "Поток цен через WebSocket",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Монеты через запятую: идентификатор, тикер или название",
+                        "name": "coins",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "usd",
+                        "description": "Валюта котировки из настройки fetcher.quotes, например usd, eur, rub, btc",
+                        "name": "vs",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Переключение на WebSocket",
+                        "schema": {
+                            "$ref": "#/definitions/server.PriceUpdateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные параметры запроса",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Монета не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Превышено число подключений",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "server.CandleResponse": {
+            "type": "object",
+            "properties": {
+                "close": {
+                    "type": "number",
+                    "example": 97012.5
+                },
+                "currency_id": {
+                    "type": "string",
+                    "example": "bitcoin"
+                },
+                "high": {
+                    "type": "number",
+                    "example": 97210
+                },
+                "interval": {
+                    "type": "string",
+                    "example": "1h"
+                },
+                "low": {
+                    "type": "number",
+                    "example": 96800
+                },
+                "open": {
+                    "type": "number",
+                    "example": 96950
+                },
+                "open_time": {
+                    "type": "string",
+                    "example": "2025-01-14T12:00:00Z"
+                },
+                "quote": {
+                    "type": "string",
+                    "example": "usd"
+                },
+                "ticks": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "server.CoinResponse": {
+            "type": "object",
+            "properties": {
+                "added_at": {
+                    "type": "string",
+                    "example": "2025-01-14T12:00:00Z"
+                },
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "id": {
+                    "type": "string",
+                    "example": "bitcoin"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Bitcoin"
+                },
+                "symbol": {
+                    "type": "string",
+                    "example": "BTC"
+                }
+            }
+        },
+        "server.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "coin_not_found"
+                },
+                "details": {
+                    "type": "object"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "coin not found: bitcoinn, did you mean: bitcoin"
+                },
+                "request_id": {
+                    "type": "string",
+                    "example": "host/abcdef-000001"
+                }
+            }
+        },
+        "server.HistoryPointResponse": {
+            "type": "object",
+            "properties": {
+                "max_price": {
+                    "type": "number",
+                    "example": 97210
+                },
+                "min_price": {
+                    "type": "number",
+                    "example": 96800
+                },
+                "price": {
+                    "type": "number",
+                    "example": 97012.5
+                },
+                "ticks": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "time": {
+                    "type": "string",
+                    "example": "2025-01-14T12:00:00Z"
+                }
+            }
+        },
+        "server.HistoryResponse": {
+            "type": "object",
+            "properties": {
+                "currency_id": {
+                    "type": "string",
+                    "example": "bitcoin"
+                },
+                "next_cursor": {
+                    "type": "string",
+                    "example": "MTczNjg1NjMwMDAwMDAwMDAwMC40Mg"
+                },
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/server.HistoryPointResponse"
+                    }
+                },
+                "quote": {
+                    "type": "string",
+                    "example": "usd"
+                },
+                "step": {
+                    "type": "string",
+                    "example": "5m0s"
+                },
+                "ticks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/server.TickResponse"
+                    }
+                }
+            }
+        },
+        "server.PortfolioResponse": {
+            "type": "object",
+            "properties": {
+                "positions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/server.PositionResponse"
+                    }
+                },
+                "totals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/server.PortfolioTotalResponse"
+                    }
+                }
+            }
+        },
+        "server.PortfolioTotalResponse": {
+            "type": "object",
+            "properties": {
+                "cost_basis": {
+                    "type": "number",
+                    "example": 31000
+                },
+                "quote": {
+                    "type": "string",
+                    "example": "usd"
+                },
+                "realized_pnl": {
+                    "type": "number",
+                    "example": 0
+                },
+                "unrealized_pnl": {
+                    "type": "number",
+                    "example": 17506.25
+                },
+                "value": {
+                    "type": "number",
+                    "example": 48506.25
+                }
+            }
+        },
+        "server.PositionResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "example": 0.5
+                },
+                "average_price": {
+                    "type": "number",
+                    "example": 62000
+                },
+                "cost_basis": {
+                    "type": "number",
+                    "example": 31000
+                },
+                "currency_id": {
+                    "type": "string",
+                    "example": "bitcoin"
+                },
+                "current_price": {
+                    "type": "number",
+                    "example": 97012.5
+                },
+                "quote": {
+                    "type": "string",
+                    "example": "usd"
+                },
+                "realized_pnl": {
+                    "type": "number",
+                    "example": 0
+                },
+                "unrealized_pnl": {
+                    "type": "number",
+                    "example": 17506.25
+                },
+                "value": {
+                    "type": "number",
+                    "example": 48506.25
+                }
+            }
+        },
+        "server.PriceUpdateResponse": {
+            "type": "object",
+            "properties": {
+                "currency_id": {
+                    "type": "string",
+                    "example": "bitcoin"
+                },
+                "observed_at": {
+                    "type": "string",
+                    "example": "2025-01-14T12:05:00Z"
+                },
+                "price": {
+                    "type": "number",
+                    "example": 97012.5
+                },
+                "quote": {
+                    "type": "string",
+                    "example": "usd"
+                },
+                "source": {
+                    "type": "string",
+                    "example": "median"
+                }
+            }
+        },
+        "server.ProviderStatusResponse": {
+            "type": "object",
+            "properties": {
+                "failures": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "coingecko"
+                },
+                "retry_at": {
+                    "type": "string"
+                },
+                "state": {
+                    "type": "string",
+                    "example": "closed"
+                }
+            }
+        },
+        "server.QuoteResponse": {
+            "type": "object",
+            "properties": {
+                "deviation_percent": {
+                    "type": "number",
+                    "example": 0.01
+                },
+                "excluded": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "observed_at": {
+                    "type": "string",
+                    "example": "2025-01-14T12:05:00Z"
+                },
+                "price": {
+                    "type": "number",
+                    "example": 97020.3
+                },
+                "source": {
+                    "type": "string",
+                    "example": "binance"
+                },
+                "volume": {
+                    "type": "number",
+                    "example": 1523.7
+                }
+            }
+        },
+        "server.RateResponse": {
+            "type": "object",
+            "properties": {
+                "change_1h": {
+                    "type": "number",
+                    "example": 0.42
+                },
+                "change_24h": {
+                    "type": "number",
+                    "example": -1.37
+                },
+                "change_7d": {
+                    "type": "number",
+                    "example": 4.8
+                },
+                "change_percent": {
+                    "description": "Deprecated: same as change_1h, kept for existing clients",
+                    "type": "number",
+                    "example": 0.42
+                },
+                "currency_id": {
+                    "type": "string",
+                    "example": "bitcoin"
+                },
+                "current_price": {
+                    "type": "number",
+                    "example": 97012.5
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2025-01-14"
+                },
+                "hour_max_price": {
+                    "type": "number",
+                    "example": 97210
+                },
+                "hour_min_price": {
+                    "type": "number",
+                    "example": 96800
+                },
+                "max_price": {
+                    "type": "number",
+                    "example": 97830.1
+                },
+                "min_price": {
+                    "type": "number",
+                    "example": 95400
+                },
+                "quote": {
+                    "type": "string",
+                    "example": "usd"
+                },
+                "sources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/server.QuoteResponse"
+                    }
+                },
+                "stale": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "timestamp": {
+                    "type": "string",
+                    "example": "2025-01-14T12:05:00Z"
+                }
+            }
+        },
+        "server.TickResponse": {
+            "type": "object",
+            "properties": {
+                "observed_at": {
+                    "type": "string",
+                    "example": "2025-01-14T12:05:00Z"
+                },
+                "price": {
+                    "type": "number",
+                    "example": 97012.5
+                },
+                "source": {
+                    "type": "string",
+                    "example": "median"
+                }
+            }
+        },
+        "server.aliasRequest": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "description": "Alternative coin name, e.g. matic",
+                    "type": "string"
+                }
+            }
+        },
+        "server.coinRequest": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "CoinGecko coin identifier",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Human readable name",
+                    "type": "string"
+                },
+                "symbol": {
+                    "description": "Ticker symbol",
+                    "type": "string"
+                }
+            }
         }
     }
 }`
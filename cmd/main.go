@@ -1,10 +1,22 @@
 // Package main contains application entry point
-// Initializes and runs the application
+// Initializes and runs the application or its maintenance subcommands
 package main
 
-import "currencyhub/internal/app"
+import (
+	"currencyhub/internal/app"
+	"fmt"
+	"os"
+)
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := app.Migrate(os.Args[2:], os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	if err := app.Run(); err != nil {
 		panic(err)
 	}
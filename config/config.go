@@ -7,6 +7,7 @@ import (
 	"github.com/joho/godotenv"
 	"gopkg.in/yaml.v3"
 	"os"
+	"time"
 )
 
 // Config represents the main application configuration structure
@@ -26,8 +27,23 @@ type Config struct {
 	Coingecko struct {
 		APIKey string `env:"COINGECKO_API_KEY"`
 	} `yaml:"coingecko"`
+	Fetcher struct {
+		Interval     time.Duration `yaml:"interval" env:"FETCH_INTERVAL"`
+		Providers    []string      `yaml:"providers" env:"FETCH_PROVIDERS"`
+		Aggregation  string        `yaml:"aggregation" env:"FETCH_AGGREGATION"`
+		MaxDeviation float64       `yaml:"max_deviation" env:"FETCH_MAX_DEVIATION"`
+		Quotes       []string      `yaml:"quotes" env:"FETCH_QUOTES"`
+		Breaker      struct {
+			FailureThreshold int           `yaml:"failure_threshold" env:"BREAKER_FAILURE_THRESHOLD"`
+			Cooldown         time.Duration `yaml:"cooldown" env:"BREAKER_COOLDOWN"`
+			MaxCooldown      time.Duration `yaml:"max_cooldown" env:"BREAKER_MAX_COOLDOWN"`
+		} `yaml:"breaker"`
+	} `yaml:"fetcher"`
 	Server struct {
-		Port string `yaml:"port" env:"SERVER_PORT"`
+		Port             string `yaml:"port" env:"SERVER_PORT"`
+		AdminToken       string `env:"ADMIN_TOKEN"`
+		MaxStreamClients int    `yaml:"max_stream_clients" env:"SERVER_MAX_STREAM_CLIENTS"`
+		StreamReplay     int    `yaml:"stream_replay" env:"SERVER_STREAM_REPLAY"`
 	} `yaml:"server"`
 	Logging struct {
 		File string `yaml:"file" env:"LOG_FILE"`
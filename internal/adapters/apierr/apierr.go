@@ -0,0 +1,120 @@
+// Package apierr describes failed responses of external HTTP APIs
+// Shared by price providers to report status codes and retry hints
+package apierr
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Error kinds of provider responses, matched with errors.Is
+var (
+	ErrRateLimited       = errors.New("rate limited")
+	ErrUnauthorized      = errors.New("unauthorized")
+	ErrServerError       = errors.New("server error")
+	ErrUnexpectedStatus  = errors.New("unexpected status")
+	ErrMalformedResponse = errors.New("malformed response")
+)
+
+// StatusError represents non-successful HTTP response of provider API
+// Carries server-requested retry delay from Retry-After header
+type StatusError struct {
+	Provider   string        // Name of the provider that responded
+	StatusCode int           // HTTP status code of the response
+	RetryAfter time.Duration // Delay requested by Retry-After header, zero if absent
+}
+
+// Error returns human-readable description of failed response
+func (e *StatusError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("%s: %s responded with status %d, retry after %s", e.Unwrap(), e.Provider, e.StatusCode, e.RetryAfter)
+	}
+	return fmt.Sprintf("%s: %s responded with status %d", e.Unwrap(), e.Provider, e.StatusCode)
+}
+
+// Unwrap returns error kind matching response status code
+func (e *StatusError) Unwrap() error {
+	switch {
+	case e.StatusCode == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden:
+		return ErrUnauthorized
+	case e.StatusCode >= 500:
+		return ErrServerError
+	}
+	return ErrUnexpectedStatus
+}
+
+// CodeError represents error code provider reported in body of response with successful status
+// Codes are provider-specific and are not HTTP statuses, so their kind is mapped by provider
+type CodeError struct {
+	Provider string // Name of the provider that responded
+	Code     int    // Provider error code from response body
+	Kind     error  // Error kind the code stands for
+}
+
+// Error returns human-readable description of reported error code
+func (e *CodeError) Error() string {
+	return fmt.Sprintf("%s: %s returned error code %d", e.Kind, e.Provider, e.Code)
+}
+
+// Unwrap returns error kind of reported code
+func (e *CodeError) Unwrap() error {
+	return e.Kind
+}
+
+// PartialError reports successful response lacking some requested coins or their quotes
+// Quotes returned along with it are valid and should be used
+type PartialError struct {
+	Provider string   // Name of the provider that responded
+	Missing  []string // Requested coins absent from response, coin/quote pairs when only some quotes are absent
+}
+
+// Error returns human-readable description of partial response
+func (e *PartialError) Error() string {
+	return fmt.Sprintf("%s response lacks %d coins: %s", e.Provider, len(e.Missing), strings.Join(e.Missing, ", "))
+}
+
+// Malformed wraps response decoding failure of provider
+func Malformed(provider string, err error) error {
+	return fmt.Errorf("%w: %s: %w", ErrMalformedResponse, provider, err)
+}
+
+// FromResponse checks HTTP response status of provider API
+// Returns nil for successful responses and StatusError otherwise
+func FromResponse(provider string, resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	return &StatusError{
+		Provider:   provider,
+		StatusCode: resp.StatusCode,
+		RetryAfter: ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+	}
+}
+
+// ParseRetryAfter parses Retry-After header in seconds or HTTP-date form
+// Returns zero for empty, invalid or past values
+func ParseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+	return 0
+}
@@ -0,0 +1,64 @@
+// Package binance provides Binance spot API client implementation
+// Implements price provider backed by 24h ticker statistics
+package binance
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// defaultBaseURL is public Binance spot API endpoint
+const defaultBaseURL = "https://api.binance.com"
+
+// symbols maps supported cryptocurrency identifiers to Binance base assets
+var symbols = map[string]string{
+	"bitcoin":          "BTC",
+	"ethereum":         "ETH",
+	"binancecoin":      "BNB",
+	"solana":           "SOL",
+	"usd-coin":         "USDC",
+	"ripple":           "XRP",
+	"the-open-network": "TON",
+	"dogecoin":         "DOGE",
+	"cardano":          "ADA",
+	"shiba-inu":        "SHIB",
+	"avalanche-2":      "AVAX",
+	"polkadot":         "DOT",
+	"tron":             "TRX",
+	"chainlink":        "LINK",
+	"polygon-pos":      "POL",
+	"bitcoin-cash":     "BCH",
+	"litecoin":         "LTC",
+	"uniswap":          "UNI",
+}
+
+// quoteAssets maps quote currencies to Binance quote assets
+// USD is represented by USDT stablecoin market, other quotes are left
+// to sources listing every pair since unknown symbol fails whole request
+var quoteAssets = map[string]string{
+	"usd": "USDT",
+}
+
+// Client manages interactions with Binance API
+// Handles HTTP requests and response processing for ticker data
+type Client struct {
+	httpClient *http.Client
+	logger     *slog.Logger
+	baseURL    string
+}
+
+// NewClient creates Binance API client instance
+// Initializes with configured HTTP client and logger
+func NewClient(logger *slog.Logger) *Client {
+	return &Client{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		logger:     logger,
+		baseURL:    defaultBaseURL,
+	}
+}
+
+// Name returns provider name used as price quote source
+func (c *Client) Name() string {
+	return "binance"
+}
@@ -0,0 +1,112 @@
+package binance
+
+import (
+	"context"
+	"currencyhub/internal/adapters/apierr"
+	"currencyhub/internal/entities"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ticker is single entry of Binance 24h ticker response
+type ticker struct {
+	Symbol      string `json:"symbol"`
+	LastPrice   string `json:"lastPrice"`
+	QuoteVolume string `json:"quoteVolume"`
+}
+
+// pair identifies currency and quote behind Binance market symbol
+type pair struct {
+	currencyID string
+	vsCurrency string
+}
+
+// FetchPrices fetches cryptocurrency prices from Binance 24h ticker endpoint
+// Coins or quote currencies without Binance market are skipped
+func (c *Client) FetchPrices(ctx context.Context, coinIDs, vsCurrencies []string) ([]*entities.PriceQuote, error) {
+	markets := make(map[string]pair)
+	var marketSymbols []string
+	for _, coinID := range coinIDs {
+		base, ok := symbols[coinID]
+		if !ok {
+			continue
+		}
+		for _, vs := range vsCurrencies {
+			quote, ok := quoteAssets[vs]
+			if !ok || quote == base {
+				continue
+			}
+			symbol := base + quote
+			markets[symbol] = pair{currencyID: coinID, vsCurrency: vs}
+			marketSymbols = append(marketSymbols, symbol)
+		}
+	}
+	if len(marketSymbols) == 0 {
+		return nil, nil
+	}
+
+	symbolsJSON, err := json.Marshal(marketSymbols)
+	if err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	params.Set("symbols", string(symbolsJSON))
+	params.Set("type", "MINI")
+
+	endpoint := fmt.Sprintf("%s/api/v3/ticker/24hr?%s", c.baseURL, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		c.logger.Error("Failed to create request", "error", err)
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.logger.Error("Failed to get prices", "error", err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := apierr.FromResponse(c.Name(), resp); err != nil {
+		c.logger.Error("Provider returned error status", "status", resp.StatusCode)
+		return nil, err
+	}
+
+	var data []ticker
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		c.logger.Error("Failed to decode response", "error", err)
+		return nil, apierr.Malformed(c.Name(), err)
+	}
+
+	observedAt := time.Now().UTC()
+	quotes := make([]*entities.PriceQuote, 0, len(data))
+	for _, item := range data {
+		market, ok := markets[item.Symbol]
+		if !ok {
+			continue
+		}
+
+		price, err := strconv.ParseFloat(item.LastPrice, 64)
+		if err != nil || price <= 0 {
+			c.logger.Warn("Invalid price in response", "symbol", item.Symbol, "price", item.LastPrice)
+			continue
+		}
+		volume, _ := strconv.ParseFloat(item.QuoteVolume, 64)
+
+		quotes = append(quotes, &entities.PriceQuote{
+			CurrencyID: market.currencyID,
+			VsCurrency: market.vsCurrency,
+			Price:      price,
+			Volume:     volume,
+			Source:     c.Name(),
+			ObservedAt: observedAt,
+		})
+	}
+
+	return quotes, nil
+}
@@ -0,0 +1,63 @@
+// Package coinbase provides Coinbase Exchange API client implementation
+// Implements price provider backed by product tickers
+package coinbase
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// defaultBaseURL is public Coinbase Exchange API endpoint
+const defaultBaseURL = "https://api.exchange.coinbase.com"
+
+// symbols maps supported cryptocurrency identifiers to Coinbase base currencies
+var symbols = map[string]string{
+	"bitcoin":      "BTC",
+	"ethereum":     "ETH",
+	"tether":       "USDT",
+	"solana":       "SOL",
+	"ripple":       "XRP",
+	"dogecoin":     "DOGE",
+	"cardano":      "ADA",
+	"shiba-inu":    "SHIB",
+	"avalanche-2":  "AVAX",
+	"polkadot":     "DOT",
+	"chainlink":    "LINK",
+	"polygon-pos":  "POL",
+	"bitcoin-cash": "BCH",
+	"litecoin":     "LTC",
+	"uniswap":      "UNI",
+	"dai":          "DAI",
+}
+
+// quoteCurrencies maps quote currencies to Coinbase quote currencies
+// Products missing for some coins are reported as failed tickers
+var quoteCurrencies = map[string]string{
+	"usd": "USD",
+	"eur": "EUR",
+	"gbp": "GBP",
+}
+
+// Client manages interactions with Coinbase Exchange API
+// Handles HTTP requests and response processing for ticker data
+type Client struct {
+	httpClient *http.Client
+	logger     *slog.Logger
+	baseURL    string
+}
+
+// NewClient creates Coinbase API client instance
+// Initializes with configured HTTP client and logger
+func NewClient(logger *slog.Logger) *Client {
+	return &Client{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		logger:     logger,
+		baseURL:    defaultBaseURL,
+	}
+}
+
+// Name returns provider name used as price quote source
+func (c *Client) Name() string {
+	return "coinbase"
+}
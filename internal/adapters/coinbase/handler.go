@@ -0,0 +1,97 @@
+package coinbase
+
+import (
+	"context"
+	"currencyhub/internal/adapters/apierr"
+	"currencyhub/internal/entities"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ticker is Coinbase product ticker response
+type ticker struct {
+	Price  string `json:"price"`
+	Volume string `json:"volume"`
+}
+
+// FetchPrices fetches cryptocurrency prices from Coinbase product tickers
+// Requests every product separately, coins without Coinbase market are skipped
+func (c *Client) FetchPrices(ctx context.Context, coinIDs, vsCurrencies []string) ([]*entities.PriceQuote, error) {
+	var quotes []*entities.PriceQuote
+	var errs []error
+
+coins:
+	for _, coinID := range coinIDs {
+		base, ok := symbols[coinID]
+		if !ok {
+			continue
+		}
+		for _, vs := range vsCurrencies {
+			quoteCurrency, ok := quoteCurrencies[vs]
+			if !ok {
+				continue
+			}
+
+			quote, err := c.fetchTicker(ctx, base+"-"+quoteCurrency)
+			if err != nil {
+				c.logger.Warn("Failed to get ticker", "coin", coinID, "vs", vs, "error", err)
+				errs = append(errs, err)
+
+				if errors.Is(err, apierr.ErrRateLimited) {
+					break coins
+				}
+				continue
+			}
+			quote.CurrencyID = coinID
+			quote.VsCurrency = vs
+			quotes = append(quotes, quote)
+		}
+	}
+
+	if len(quotes) == 0 && len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return quotes, nil
+}
+
+// fetchTicker requests ticker of single Coinbase product
+func (c *Client) fetchTicker(ctx context.Context, product string) (*entities.PriceQuote, error) {
+	endpoint := fmt.Sprintf("%s/products/%s/ticker", c.baseURL, product)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := apierr.FromResponse(c.Name(), resp); err != nil {
+		return nil, err
+	}
+
+	var data ticker
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, apierr.Malformed(c.Name(), fmt.Errorf("%s ticker: %w", product, err))
+	}
+
+	price, err := strconv.ParseFloat(data.Price, 64)
+	if err != nil || price <= 0 {
+		return nil, fmt.Errorf("invalid %s price: %q", product, data.Price)
+	}
+	volume, _ := strconv.ParseFloat(data.Volume, 64)
+
+	return &entities.PriceQuote{
+		Price:      price,
+		Volume:     volume * price,
+		Source:     c.Name(),
+		ObservedAt: time.Now().UTC(),
+	}, nil
+}
@@ -0,0 +1,151 @@
+package coingecko
+
+import (
+	"context"
+	"currencyhub/internal/adapters/apierr"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeCoinGecko starts server answering simple price requests with given status and body
+func newFakeCoinGecko(t *testing.T, status int, body string, header http.Header) (*Client, *http.Request) {
+	t.Helper()
+
+	var received http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = *r
+		for key, values := range header {
+			w.Header()[key] = values
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		io.WriteString(w, body)
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(slog.New(slog.NewTextHandler(io.Discard, nil)), "demo-key")
+	client.baseURL = server.URL
+	return client, &received
+}
+
+func TestFetchPrices_Success(t *testing.T) {
+	client, received := newFakeCoinGecko(t, http.StatusOK,
+		`{"bitcoin":{"usd":97000.5},"ethereum":{"usd":3300}}`, nil)
+
+	quotes, err := client.FetchPrices(context.Background(), []string{"bitcoin", "ethereum"}, []string{"usd"})
+
+	require.NoError(t, err)
+	require.Len(t, quotes, 2)
+	assert.Equal(t, "bitcoin", quotes[0].CurrencyID)
+	assert.Equal(t, 97000.5, quotes[0].Price)
+	assert.Equal(t, "coingecko", quotes[0].Source)
+	assert.Equal(t, "/simple/price", received.URL.Path)
+	assert.Equal(t, "bitcoin,ethereum", received.URL.Query().Get("ids"))
+	assert.Equal(t, "demo-key", received.URL.Query().Get("x_cg_demo_api_key"))
+}
+
+func TestFetchPrices_PartialResponse(t *testing.T) {
+	client, _ := newFakeCoinGecko(t, http.StatusOK,
+		`{"bitcoin":{"usd":97000.5},"tether":{}}`, nil)
+
+	quotes, err := client.FetchPrices(context.Background(), []string{"bitcoin", "ethereum", "tether"}, []string{"usd"})
+
+	var partial *apierr.PartialError
+	require.ErrorAs(t, err, &partial)
+	assert.Equal(t, []string{"ethereum", "tether"}, partial.Missing)
+	require.Len(t, quotes, 1)
+	assert.Equal(t, "bitcoin", quotes[0].CurrencyID)
+}
+
+func TestFetchPrices_MissingQuote(t *testing.T) {
+	client, _ := newFakeCoinGecko(t, http.StatusOK,
+		`{"bitcoin":{"usd":97000.5,"eur":89000},"ethereum":{"eur":3000}}`, nil)
+
+	quotes, err := client.FetchPrices(context.Background(), []string{"bitcoin", "ethereum"}, []string{"usd", "eur"})
+
+	var partial *apierr.PartialError
+	require.ErrorAs(t, err, &partial)
+	assert.Equal(t, []string{"ethereum/usd"}, partial.Missing)
+	require.Len(t, quotes, 3)
+	assert.Equal(t, "ethereum", quotes[2].CurrencyID)
+	assert.Equal(t, "eur", quotes[2].VsCurrency)
+}
+
+func TestFetchPrices_ErrorResponses(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		header http.Header
+		kind   error
+	}{
+		{"rate limited", http.StatusTooManyRequests, `{"status":{"error_code":429}}`, http.Header{"Retry-After": {"60"}}, apierr.ErrRateLimited},
+		{"unauthorized", http.StatusUnauthorized, `{"status":{"error_code":10002}}`, nil, apierr.ErrUnauthorized},
+		{"server error", http.StatusBadGateway, `<html>bad gateway</html>`, nil, apierr.ErrServerError},
+		{"error json with ok status", http.StatusOK, `{"status":{"error_code":429,"error_message":"You've exceeded the Rate Limit"}}`, nil, apierr.ErrRateLimited},
+		{"error field", http.StatusOK, `{"error":"invalid vs_currency"}`, nil, apierr.ErrMalformedResponse},
+		{"not json", http.StatusOK, `not json`, nil, apierr.ErrMalformedResponse},
+		{"empty object", http.StatusOK, `{}`, nil, apierr.ErrMalformedResponse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, _ := newFakeCoinGecko(t, tt.status, tt.body, tt.header)
+
+			quotes, err := client.FetchPrices(context.Background(), []string{"bitcoin"}, []string{"usd"})
+
+			assert.ErrorIs(t, err, tt.kind)
+			assert.Nil(t, quotes)
+		})
+	}
+}
+
+func TestFetchPrices_ErrorCodes(t *testing.T) {
+	tests := []struct {
+		code int
+		kind error
+	}{
+		{429, apierr.ErrRateLimited},
+		{401, apierr.ErrUnauthorized},
+		{403, apierr.ErrUnauthorized},
+		{1020, apierr.ErrUnauthorized},
+		{10002, apierr.ErrUnauthorized},
+		{10005, apierr.ErrUnauthorized},
+		{10010, apierr.ErrUnauthorized},
+		{10011, apierr.ErrUnauthorized},
+		{12345, apierr.ErrUnexpectedStatus},
+	}
+
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.code), func(t *testing.T) {
+			client, _ := newFakeCoinGecko(t, http.StatusOK, fmt.Sprintf(`{"status":{"error_code":%d}}`, tt.code), nil)
+
+			_, err := client.FetchPrices(context.Background(), []string{"bitcoin"}, []string{"usd"})
+
+			var codeErr *apierr.CodeError
+			require.ErrorAs(t, err, &codeErr)
+			assert.Equal(t, tt.code, codeErr.Code)
+			assert.ErrorIs(t, err, tt.kind)
+			assert.NotErrorIs(t, err, apierr.ErrServerError)
+		})
+	}
+}
+
+func TestFetchPrices_RetryAfter(t *testing.T) {
+	client, _ := newFakeCoinGecko(t, http.StatusTooManyRequests, `{}`, http.Header{"Retry-After": {"60"}})
+
+	_, err := client.FetchPrices(context.Background(), []string{"bitcoin"}, []string{"usd"})
+
+	var statusErr *apierr.StatusError
+	require.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, 60*time.Second, statusErr.RetryAfter)
+}
@@ -2,55 +2,151 @@ package coingecko
 
 import (
 	"context"
+	"currencyhub/internal/adapters/apierr"
 	"currencyhub/internal/entities"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
+	"time"
 )
 
-// GetPrices fetches cryptocurrency prices from CoinGecko API
-// Retrieves current market data for all supported currencies
-func (c *Client) GetPrices(ctx context.Context) error {
-	coinIDs := entities.CurrencyList
+// errorBody describes error payloads CoinGecko sends instead of prices
+type errorBody struct {
+	Status *struct {
+		ErrorCode    int    `json:"error_code"`
+		ErrorMessage string `json:"error_message"`
+	} `json:"status"`
+	Error string `json:"error"`
+}
 
-	url := fmt.Sprintf("https://api.coingecko.com/api/v3/simple/price?ids=%s&vs_currencies=usd",
-		strings.Join(coinIDs, ","))
+// errorCodeKinds maps error codes of CoinGecko response body to error kinds
+// Unknown codes are reported as unexpected, so they are not mistaken for outages
+var errorCodeKinds = map[int]error{
+	401:   apierr.ErrUnauthorized, // Unauthorized
+	403:   apierr.ErrUnauthorized, // Forbidden
+	429:   apierr.ErrRateLimited,  // Rate limit exceeded
+	1020:  apierr.ErrUnauthorized, // Access denied by firewall
+	10002: apierr.ErrUnauthorized, // API key missing
+	10005: apierr.ErrUnauthorized, // Endpoint not available in plan of API key
+	10010: apierr.ErrUnauthorized, // Pro API key used with public API
+	10011: apierr.ErrUnauthorized, // Demo API key used with Pro API
+}
 
+// FetchPrices fetches cryptocurrency prices from CoinGecko API
+// Returns quotes with apierr.PartialError when some requested coins or their quotes are missing
+func (c *Client) FetchPrices(ctx context.Context, coinIDs, vsCurrencies []string) ([]*entities.PriceQuote, error) {
+	params := url.Values{}
+	params.Set("ids", strings.Join(coinIDs, ","))
+	params.Set("vs_currencies", strings.Join(vsCurrencies, ","))
 	if c.apiKey != "" {
-		url += "&x_cg_demo_api_key=" + c.apiKey
+		params.Set("x_cg_demo_api_key", c.apiKey)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	endpoint := fmt.Sprintf("%s/simple/price?%s", c.baseURL, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
 	if err != nil {
 		c.logger.Error("Failed to create request", "error", err)
-		return err
+		return nil, err
 	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		c.logger.Error("Failed to get prices", "error", err)
-		return err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
-	var data map[string]map[string]float64
+	if err := apierr.FromResponse(c.Name(), resp); err != nil {
+		c.logger.Error("Provider returned error status", "status", resp.StatusCode)
+		return nil, err
+	}
+
+	var data map[string]json.RawMessage
 	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
 		c.logger.Error("Failed to decode response", "error", err)
-		return err
+		return nil, apierr.Malformed(c.Name(), err)
+	}
+	if err := c.checkErrorBody(data); err != nil {
+		c.logger.Error("Provider returned error body", "error", err)
+		return nil, err
 	}
 
-	for coinID, priceData := range data {
-		price, ok := priceData["usd"]
-		if !ok {
-			c.logger.Warn("Price not found for coin", "coin", coinID)
+	observedAt := time.Now().UTC()
+	quotes := make([]*entities.PriceQuote, 0, len(coinIDs)*len(vsCurrencies))
+	var missing []string
+	for _, coinID := range coinIDs {
+		var priceData map[string]float64
+		raw, ok := data[coinID]
+		if !ok || json.Unmarshal(raw, &priceData) != nil {
+			missing = append(missing, coinID)
 			continue
 		}
 
-		if err := c.repo.SavePrice(ctx, coinID, price); err != nil {
-			c.logger.Error("Failed to save price", "coin", coinID, "error", err)
+		// Each coin/quote pair is either returned or reported missing, never both
+		var missingVs []string
+		for _, vs := range vsCurrencies {
+			price, ok := priceData[vs]
+			if !ok || price <= 0 {
+				missingVs = append(missingVs, vs)
+				continue
+			}
+
+			quotes = append(quotes, &entities.PriceQuote{
+				CurrencyID: coinID,
+				VsCurrency: vs,
+				Price:      price,
+				Source:     c.Name(),
+				ObservedAt: observedAt,
+			})
+		}
+		if len(missingVs) > 0 && len(missingVs) == len(vsCurrencies) {
+			missing = append(missing, coinID)
+			continue
+		}
+		for _, vs := range missingVs {
+			missing = append(missing, coinID+"/"+vs)
 		}
 	}
 
+	if len(quotes) == 0 && len(coinIDs) > 0 {
+		return nil, apierr.Malformed(c.Name(), errors.New("no requested prices in response"))
+	}
+	if len(missing) > 0 {
+		c.logger.Warn("Prices not found for coins", "coins", missing)
+		return quotes, &apierr.PartialError{Provider: c.Name(), Missing: missing}
+	}
+
+	return quotes, nil
+}
+
+// checkErrorBody detects error payload returned with successful status
+// Maps embedded error code to typed status error
+func (c *Client) checkErrorBody(data map[string]json.RawMessage) error {
+	_, hasStatus := data["status"]
+	_, hasError := data["error"]
+	if !hasStatus && !hasError {
+		return nil
+	}
+
+	var body errorBody
+	raw, _ := json.Marshal(data)
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return apierr.Malformed(c.Name(), err)
+	}
+
+	if body.Status != nil && body.Status.ErrorCode != 0 {
+		kind, ok := errorCodeKinds[body.Status.ErrorCode]
+		if !ok {
+			kind = apierr.ErrUnexpectedStatus
+		}
+		return &apierr.CodeError{Provider: c.Name(), Code: body.Status.ErrorCode, Kind: kind}
+	}
+	if body.Error != "" {
+		return apierr.Malformed(c.Name(), errors.New(body.Error))
+	}
 	return nil
 }
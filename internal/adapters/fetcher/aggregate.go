@@ -0,0 +1,115 @@
+package fetcher
+
+import (
+	"currencyhub/internal/entities"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Supported aggregation methods
+// Failover uses first available provider in configured order
+const (
+	MethodMedian   = "median"
+	MethodVWAP     = "vwap"
+	MethodFailover = "failover"
+)
+
+// ErrNoConsensus is returned when every quote was rejected as outlier
+var ErrNoConsensus = errors.New("no consensus between price sources")
+
+// Aggregator combines quotes of several providers into single price
+// Quotes deviating from median beyond MaxDeviation percent are excluded
+type Aggregator struct {
+	Method       string  // Aggregation method: median, vwap or failover
+	MaxDeviation float64 // Allowed deviation from median in percent, zero disables rejection
+}
+
+// Aggregate computes price from quotes of one coin in one quote currency
+// Marks every quote with its deviation and exclusion flag
+func (a Aggregator) Aggregate(quotes []*entities.PriceQuote) (float64, error) {
+	if len(quotes) == 0 {
+		return 0, errors.New("no quotes to aggregate")
+	}
+
+	prices := make([]float64, 0, len(quotes))
+	for _, quote := range quotes {
+		prices = append(prices, quote.Price)
+	}
+	reference := median(prices)
+	if reference <= 0 {
+		return 0, fmt.Errorf("invalid median price: %f", reference)
+	}
+
+	accepted := make([]*entities.PriceQuote, 0, len(quotes))
+	for _, quote := range quotes {
+		quote.Deviation = (quote.Price - reference) / reference * 100
+		quote.Excluded = a.MaxDeviation > 0 && math.Abs(quote.Deviation) > a.MaxDeviation
+		if !quote.Excluded {
+			accepted = append(accepted, quote)
+		}
+	}
+	if len(accepted) == 0 {
+		return 0, ErrNoConsensus
+	}
+
+	if a.Method == MethodVWAP {
+		if price, ok := volumeWeighted(accepted); ok {
+			return price, nil
+		}
+	}
+
+	prices = prices[:0]
+	for _, quote := range accepted {
+		prices = append(prices, quote.Price)
+	}
+	return median(prices), nil
+}
+
+// Source returns tick source name for aggregated quotes
+// Single contributing provider is reported by its own name, otherwise method actually used,
+// so VWAP round that fell back to median for missing volumes is reported as median
+func (a Aggregator) Source(quotes []*entities.PriceQuote) string {
+	accepted := make([]*entities.PriceQuote, 0, len(quotes))
+	for _, quote := range quotes {
+		if !quote.Excluded {
+			accepted = append(accepted, quote)
+		}
+	}
+	if len(accepted) == 1 {
+		return accepted[0].Source
+	}
+	if a.Method == MethodVWAP {
+		if _, ok := volumeWeighted(accepted); ok {
+			return MethodVWAP
+		}
+	}
+	return MethodMedian
+}
+
+// median returns middle value of prices, averaging two middle values for even count
+func median(prices []float64) float64 {
+	sorted := append([]float64(nil), prices...)
+	sort.Float64s(sorted)
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+	return sorted[middle]
+}
+
+// volumeWeighted returns volume-weighted average price
+// Reports false when any quote lacks volume information
+func volumeWeighted(quotes []*entities.PriceQuote) (float64, bool) {
+	var sum, volume float64
+	for _, quote := range quotes {
+		if quote.Volume <= 0 {
+			return 0, false
+		}
+		sum += quote.Price * quote.Volume
+		volume += quote.Volume
+	}
+	return sum / volume, true
+}
@@ -0,0 +1,88 @@
+package fetcher
+
+import (
+	"currencyhub/internal/entities"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func quotes(prices ...float64) []*entities.PriceQuote {
+	var result []*entities.PriceQuote
+	for i, price := range prices {
+		result = append(result, &entities.PriceQuote{
+			CurrencyID: "bitcoin",
+			VsCurrency: "usd",
+			Price:      price,
+			Source:     []string{"coingecko", "binance", "coinbase", "kraken"}[i],
+		})
+	}
+	return result
+}
+
+func TestAggregator_Median(t *testing.T) {
+	aggregator := Aggregator{Method: MethodMedian}
+
+	price, err := aggregator.Aggregate(quotes(100, 103, 101))
+
+	require.NoError(t, err)
+	assert.Equal(t, 101.0, price)
+
+	price, err = aggregator.Aggregate(quotes(100, 102))
+
+	require.NoError(t, err)
+	assert.Equal(t, 101.0, price)
+}
+
+func TestAggregator_RejectsOutlier(t *testing.T) {
+	aggregator := Aggregator{Method: MethodMedian, MaxDeviation: 2}
+	input := quotes(0.5, 100, 101)
+
+	price, err := aggregator.Aggregate(input)
+
+	require.NoError(t, err)
+	assert.Equal(t, 100.5, price)
+	assert.True(t, input[0].Excluded)
+	assert.False(t, input[1].Excluded)
+	assert.InDelta(t, -99.5, input[0].Deviation, 0.001)
+	assert.Equal(t, MethodMedian, aggregator.Source(input))
+}
+
+func TestAggregator_NoConsensus(t *testing.T) {
+	aggregator := Aggregator{Method: MethodMedian, MaxDeviation: 1}
+
+	_, err := aggregator.Aggregate(quotes(100, 110))
+
+	assert.ErrorIs(t, err, ErrNoConsensus)
+}
+
+func TestAggregator_VWAP(t *testing.T) {
+	aggregator := Aggregator{Method: MethodVWAP}
+	input := quotes(100, 110)
+	input[0].Volume = 3
+	input[1].Volume = 1
+
+	price, err := aggregator.Aggregate(input)
+
+	require.NoError(t, err)
+	assert.Equal(t, 102.5, price)
+	assert.Equal(t, MethodVWAP, aggregator.Source(input))
+
+	input[1].Volume = 0
+	price, err = aggregator.Aggregate(input)
+
+	require.NoError(t, err)
+	assert.Equal(t, 105.0, price)
+	assert.Equal(t, MethodMedian, aggregator.Source(input), "median fallback is reported as median")
+}
+
+func TestAggregator_SingleSourceName(t *testing.T) {
+	aggregator := Aggregator{Method: MethodMedian}
+	input := quotes(100)
+
+	_, err := aggregator.Aggregate(input)
+
+	require.NoError(t, err)
+	assert.Equal(t, "coingecko", aggregator.Source(input))
+}
@@ -0,0 +1,199 @@
+package fetcher
+
+import (
+	"currencyhub/internal/adapters/apierr"
+	"currencyhub/internal/entities"
+	"currencyhub/monitoring"
+	"errors"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+// CircuitState describes whether provider requests are allowed
+type CircuitState int
+
+// Circuit breaker states
+const (
+	StateClosed CircuitState = iota
+	StateHalfOpen
+	StateOpen
+)
+
+// String returns state name used in status output
+func (s CircuitState) String() string {
+	switch s {
+	case StateHalfOpen:
+		return "half-open"
+	case StateOpen:
+		return "open"
+	}
+	return "closed"
+}
+
+// BreakerSettings configures circuit breakers of all providers
+// Zero values are replaced with defaults
+type BreakerSettings struct {
+	FailureThreshold int           // Consecutive failures that open circuit
+	Cooldown         time.Duration // Initial open period before half-open probe
+	MaxCooldown      time.Duration // Upper bound of exponentially growing open period
+}
+
+// Default circuit breaker settings
+const (
+	DefaultFailureThreshold = 3
+	DefaultCooldown         = 30 * time.Second
+	DefaultMaxCooldown      = 30 * time.Minute
+)
+
+// withDefaults fills unset settings with default values
+func (s BreakerSettings) withDefaults() BreakerSettings {
+	if s.FailureThreshold <= 0 {
+		s.FailureThreshold = DefaultFailureThreshold
+	}
+	if s.Cooldown <= 0 {
+		s.Cooldown = DefaultCooldown
+	}
+	if s.MaxCooldown < s.Cooldown {
+		s.MaxCooldown = max(DefaultMaxCooldown, s.Cooldown)
+	}
+	return s
+}
+
+// Breaker guards single provider with closed/open/half-open circuit
+// Open period grows exponentially with jitter and honors Retry-After
+type Breaker struct {
+	mu        sync.Mutex
+	name      string
+	settings  BreakerSettings
+	state     CircuitState
+	failures  int
+	trips     int
+	probing   bool
+	retryAt   time.Time
+	lastError string
+	now       func() time.Time
+	jitter    func() float64
+}
+
+// NewBreaker creates closed circuit breaker for named provider
+func NewBreaker(name string, settings BreakerSettings) *Breaker {
+	b := &Breaker{
+		name:     name,
+		settings: settings.withDefaults(),
+		now:      time.Now,
+		jitter:   rand.Float64,
+	}
+	monitoring.ProviderCircuitState.WithLabelValues(name).Set(float64(StateClosed))
+	return b
+}
+
+// Allow reports whether provider may be requested now
+// Open circuit turns half-open after cooldown and admits single probe
+func (b *Breaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case StateOpen:
+		if b.now().Before(b.retryAt) {
+			return false
+		}
+		b.setState(StateHalfOpen)
+		b.probing = true
+		return true
+	case StateHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	}
+	return true
+}
+
+// Success records successful request and closes circuit
+func (b *Breaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	monitoring.ProviderRequestsTotal.WithLabelValues(b.name, "success").Inc()
+	b.failures = 0
+	b.trips = 0
+	b.probing = false
+	b.lastError = ""
+	b.setState(StateClosed)
+}
+
+// Failure records failed request and opens circuit when needed
+// Rate limit responses and failed probes open circuit immediately
+func (b *Breaker) Failure(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	monitoring.ProviderRequestsTotal.WithLabelValues(b.name, "failure").Inc()
+	b.failures++
+	b.lastError = err.Error()
+
+	rateLimited := errors.Is(err, apierr.ErrRateLimited)
+	if b.state == StateHalfOpen || rateLimited || b.failures >= b.settings.FailureThreshold {
+		b.trip(err)
+	}
+}
+
+// RetryAt returns earliest moment of next allowed request
+// Returns zero time for closed circuit
+func (b *Breaker) RetryAt() time.Time {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == StateOpen {
+		return b.retryAt
+	}
+	return time.Time{}
+}
+
+// Status returns current breaker state for status reporting
+func (b *Breaker) Status() *entities.ProviderStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	status := &entities.ProviderStatus{
+		Name:      b.name,
+		State:     b.state.String(),
+		Failures:  b.failures,
+		LastError: b.lastError,
+	}
+	if b.state == StateOpen {
+		status.RetryAt = b.retryAt
+	}
+	return status
+}
+
+// trip opens circuit for exponentially growing period with jitter
+// Server-requested Retry-After delay is used when it is longer
+func (b *Breaker) trip(err error) {
+	b.trips++
+	b.probing = false
+
+	cooldown := b.settings.Cooldown
+	for i := 1; i < b.trips && cooldown < b.settings.MaxCooldown; i++ {
+		cooldown *= 2
+	}
+	cooldown = min(cooldown, b.settings.MaxCooldown)
+	cooldown = cooldown/2 + time.Duration(b.jitter()*float64(cooldown/2))
+
+	var statusErr *apierr.StatusError
+	if errors.As(err, &statusErr) && statusErr.RetryAfter > cooldown {
+		cooldown = statusErr.RetryAfter
+	}
+
+	b.retryAt = b.now().Add(cooldown)
+	b.setState(StateOpen)
+}
+
+// setState changes circuit state and exports it as metric
+func (b *Breaker) setState(state CircuitState) {
+	b.state = state
+	monitoring.ProviderCircuitState.WithLabelValues(b.name).Set(float64(state))
+}
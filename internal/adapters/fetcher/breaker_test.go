@@ -0,0 +1,68 @@
+package fetcher
+
+import (
+	"currencyhub/internal/adapters/apierr"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestBreaker(settings BreakerSettings) (*Breaker, *time.Time) {
+	now := time.Date(2025, 1, 14, 12, 0, 0, 0, time.UTC)
+	breaker := NewBreaker("test", settings)
+	breaker.now = func() time.Time { return now }
+	breaker.jitter = func() float64 { return 1 }
+	return breaker, &now
+}
+
+func TestBreaker_OpensAfterThreshold(t *testing.T) {
+	breaker, now := newTestBreaker(BreakerSettings{FailureThreshold: 2, Cooldown: time.Minute, MaxCooldown: time.Hour})
+
+	breaker.Failure(errors.New("timeout"))
+	assert.True(t, breaker.Allow())
+
+	breaker.Failure(errors.New("timeout"))
+	assert.False(t, breaker.Allow())
+	assert.Equal(t, "open", breaker.Status().State)
+	assert.Equal(t, now.Add(time.Minute), breaker.RetryAt())
+}
+
+func TestBreaker_HalfOpenProbe(t *testing.T) {
+	breaker, now := newTestBreaker(BreakerSettings{FailureThreshold: 1, Cooldown: time.Minute, MaxCooldown: time.Hour})
+
+	breaker.Failure(errors.New("timeout"))
+	*now = now.Add(time.Minute)
+
+	assert.True(t, breaker.Allow(), "first request after cooldown is a probe")
+	assert.False(t, breaker.Allow(), "only one probe at a time")
+	assert.Equal(t, "half-open", breaker.Status().State)
+
+	breaker.Failure(errors.New("timeout"))
+	assert.Equal(t, now.Add(2*time.Minute), breaker.RetryAt(), "cooldown doubles after failed probe")
+
+	*now = now.Add(2 * time.Minute)
+	assert.True(t, breaker.Allow())
+	breaker.Success()
+	assert.Equal(t, "closed", breaker.Status().State)
+	assert.True(t, breaker.Allow())
+}
+
+func TestBreaker_HonorsRetryAfter(t *testing.T) {
+	breaker, now := newTestBreaker(BreakerSettings{FailureThreshold: 5, Cooldown: time.Minute, MaxCooldown: time.Hour})
+
+	breaker.Failure(&apierr.StatusError{Provider: "test", StatusCode: http.StatusTooManyRequests, RetryAfter: 10 * time.Minute})
+
+	assert.False(t, breaker.Allow(), "rate limit opens circuit immediately")
+	assert.Equal(t, now.Add(10*time.Minute), breaker.RetryAt())
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 14, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 120*time.Second, apierr.ParseRetryAfter("120", now))
+	assert.Equal(t, 30*time.Second, apierr.ParseRetryAfter(now.Add(30*time.Second).Format(http.TimeFormat), now))
+	assert.Zero(t, apierr.ParseRetryAfter("soon", now))
+}
@@ -0,0 +1,330 @@
+// Package fetcher provides scheduled price collection from external providers
+// Drives price providers and hands received quotes to persistence
+package fetcher
+
+import (
+	"context"
+	"currencyhub/internal/adapters/apierr"
+	"currencyhub/internal/entities"
+	"currencyhub/internal/interfaces"
+	"currencyhub/internal/usecases"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultInterval is used when fetch interval is not configured
+// MinRetryDelay bounds retries after failed update from below
+const (
+	DefaultInterval = 5 * time.Minute
+	MinRetryDelay   = 5 * time.Second
+)
+
+// ErrProvidersUnavailable is returned when no provider returned prices
+// ErrStalePrices is returned when some coins received no price in update cycle
+var (
+	ErrProvidersUnavailable = errors.New("no price provider available")
+	ErrStalePrices          = errors.New("prices left stale")
+)
+
+// errCircuitOpen is reported for providers skipped by open circuit
+var errCircuitOpen = errors.New("circuit open")
+
+// Fetcher periodically collects prices from configured providers
+// Aggregates quotes of all sources and saves result through currency use case
+type Fetcher struct {
+	logger       *slog.Logger
+	providers    []interfaces.PriceProvider
+	breakers     []*Breaker
+	repo         *usecase.CurrencyUseCase
+	coins        *usecase.CoinUseCase
+	events       interfaces.EventPublisher
+	aggregator   Aggregator
+	interval     time.Duration
+	vsCurrencies []string
+	failedRuns   int
+}
+
+// NewFetcher creates price fetcher instance
+// Initializes with providers guarded by circuit breakers, coin catalog, event publisher, aggregation, quote currencies and update interval
+func NewFetcher(logger *slog.Logger, providers []interfaces.PriceProvider, repo *usecase.CurrencyUseCase, coins *usecase.CoinUseCase, events interfaces.EventPublisher, aggregator Aggregator, breaker BreakerSettings, vsCurrencies []string, interval time.Duration) *Fetcher {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	if len(vsCurrencies) == 0 {
+		vsCurrencies = []string{entities.DefaultQuote}
+	}
+
+	breakers := make([]*Breaker, len(providers))
+	for i, provider := range providers {
+		breakers[i] = NewBreaker(provider.Name(), breaker)
+	}
+
+	return &Fetcher{
+		logger:       logger,
+		providers:    providers,
+		breakers:     breakers,
+		repo:         repo,
+		coins:        coins,
+		events:       events,
+		aggregator:   aggregator,
+		interval:     interval,
+		vsCurrencies: vsCurrencies,
+	}
+}
+
+// Run starts periodic currency update scheduler
+// Retries sooner with backoff when no provider was available
+func (f *Fetcher) Run(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	update := func() time.Duration {
+		f.logger.Info("Starting currency update", "providers", len(f.providers))
+		err := f.Fetch(ctx)
+		delay := f.nextDelay(err)
+		if err != nil {
+			f.logger.Error("Failed to update prices", "error", err, "next_attempt_in", delay)
+			f.events.Publish(entities.FetchFailed{
+				Err:         err,
+				Unavailable: errors.Is(err, ErrProvidersUnavailable),
+				RetryIn:     delay,
+				At:          time.Now().UTC(),
+			})
+			return delay
+		}
+		f.logger.Info("Currency update completed successfully")
+		return delay
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			f.logger.Info("Updater stopped")
+			return
+		case <-timer.C:
+			timer.Reset(update())
+		}
+	}
+}
+
+// ProviderStatuses returns circuit state of every provider in configured order
+func (f *Fetcher) ProviderStatuses() []*entities.ProviderStatus {
+	statuses := make([]*entities.ProviderStatus, 0, len(f.breakers))
+	for _, breaker := range f.breakers {
+		statuses = append(statuses, breaker.Status())
+	}
+	return statuses
+}
+
+// nextDelay calculates pause before next update cycle
+// After total provider failure uses exponential backoff with jitter or earliest circuit probe
+func (f *Fetcher) nextDelay(err error) time.Duration {
+	if !errors.Is(err, ErrProvidersUnavailable) {
+		f.failedRuns = 0
+		return f.interval
+	}
+	f.failedRuns++
+
+	delay := MinRetryDelay
+	for i := 1; i < f.failedRuns && delay < f.interval; i++ {
+		delay *= 2
+	}
+	delay = delay/2 + time.Duration(rand.Float64()*float64(delay/2))
+
+	var earliest time.Time
+	for _, breaker := range f.breakers {
+		if at := breaker.RetryAt(); !at.IsZero() && (earliest.IsZero() || at.Before(earliest)) {
+			earliest = at
+		}
+	}
+	if !earliest.IsZero() {
+		delay = max(delay, time.Until(earliest))
+	}
+
+	return min(max(delay, MinRetryDelay), f.interval)
+}
+
+// Fetch performs single update cycle
+// Requests prices of tracked catalog coins, aggregates and saves them as ticks per quote currency
+func (f *Fetcher) Fetch(ctx context.Context) error {
+	coinIDs, err := f.coins.CoinIDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load coin catalog: %w", err)
+	}
+	if len(coinIDs) == 0 {
+		f.logger.Warn("No coins enabled in catalog")
+		return nil
+	}
+
+	quotes, err := f.collect(ctx, coinIDs)
+	if err != nil {
+		return err
+	}
+
+	byMarket := make(map[market][]*entities.PriceQuote)
+	for _, quote := range quotes {
+		key := market{currencyID: quote.CurrencyID, vsCurrency: quote.VsCurrency}
+		byMarket[key] = append(byMarket[key], quote)
+	}
+
+	observedAt := time.Now().UTC()
+	var errs []error
+	for _, vs := range f.vsCurrencies {
+		var stale []string
+		for _, coinID := range coinIDs {
+			group, ok := byMarket[market{currencyID: coinID, vsCurrency: vs}]
+			if !ok {
+				stale = append(stale, coinID)
+				continue
+			}
+
+			price, err := f.aggregator.Aggregate(group)
+			if err != nil {
+				f.logger.Warn("Failed to aggregate price", "coin", coinID, "vs", vs, "error", err)
+				errs = append(errs, fmt.Errorf("%s/%s: %w", coinID, vs, err))
+				stale = append(stale, coinID)
+				continue
+			}
+			for _, quote := range group {
+				if quote.Excluded {
+					f.logger.Warn("Quote rejected as outlier", "coin", coinID, "vs", vs, "source", quote.Source,
+						"price", quote.Price, "deviation", quote.Deviation)
+				}
+			}
+
+			tick := &entities.PriceTick{
+				CurrencyID: coinID,
+				Quote:      vs,
+				Price:      price,
+				ObservedAt: observedAt,
+				Source:     f.aggregator.Source(group),
+				Quotes:     group,
+			}
+			if err := f.repo.SavePrice(ctx, tick); err != nil {
+				f.logger.Error("Failed to save price", "coin", coinID, "vs", vs, "error", err)
+				errs = append(errs, err)
+				continue
+			}
+			f.events.Publish(entities.PriceUpdated{Tick: tick})
+		}
+
+		if len(stale) > 0 {
+			f.logger.Warn("No price received for coins", "vs", vs, "coins", stale)
+			if err := f.repo.MarkStale(ctx, vs, stale); err != nil {
+				errs = append(errs, fmt.Errorf("failed to mark stale %s prices: %w", vs, err))
+			}
+			errs = append(errs, fmt.Errorf("%w: %s: %s", ErrStalePrices, vs, strings.Join(stale, ", ")))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// collect requests prices from providers allowed by their circuit breakers
+// Failover method asks next provider in order only for coins still lacking prices
+func (f *Fetcher) collect(ctx context.Context, coinIDs []string) ([]*entities.PriceQuote, error) {
+	results := make([][]*entities.PriceQuote, len(f.providers))
+	errs := make([]error, len(f.providers))
+
+	if f.aggregator.Method == MethodFailover {
+		covered := make(map[market]bool)
+		remaining := coinIDs
+		for i := range f.providers {
+			if len(remaining) == 0 {
+				break
+			}
+			results[i], errs[i] = f.request(ctx, i, remaining)
+			results[i] = firstQuotes(covered, results[i])
+			remaining = f.uncovered(remaining, covered)
+		}
+	} else {
+		var wg sync.WaitGroup
+		for i := range f.providers {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				results[i], errs[i] = f.request(ctx, i, coinIDs)
+			}(i)
+		}
+		wg.Wait()
+	}
+
+	var quotes []*entities.PriceQuote
+	var failed []error
+	for i, provider := range f.providers {
+		if errs[i] != nil {
+			if !errors.Is(errs[i], errCircuitOpen) {
+				f.logger.Error("Provider request failed", "provider", provider.Name(), "error", errs[i])
+			}
+			failed = append(failed, fmt.Errorf("provider %s: %w", provider.Name(), errs[i]))
+			continue
+		}
+		quotes = append(quotes, results[i]...)
+	}
+
+	if len(quotes) == 0 && len(failed) > 0 {
+		return nil, fmt.Errorf("%w: %w", ErrProvidersUnavailable, errors.Join(failed...))
+	}
+	return quotes, nil
+}
+
+// request calls single provider through its circuit breaker
+func (f *Fetcher) request(ctx context.Context, i int, coinIDs []string) ([]*entities.PriceQuote, error) {
+	breaker := f.breakers[i]
+	if !breaker.Allow() {
+		return nil, errCircuitOpen
+	}
+
+	quotes, err := f.providers[i].FetchPrices(ctx, coinIDs, f.vsCurrencies)
+	var partial *apierr.PartialError
+	if errors.As(err, &partial) {
+		f.logger.Warn("Provider returned partial response", "provider", partial.Provider, "missing", partial.Missing)
+		err = nil
+	}
+	if err != nil {
+		breaker.Failure(err)
+		return nil, err
+	}
+	breaker.Success()
+	return quotes, nil
+}
+
+// market identifies coin priced in single quote currency
+type market struct {
+	currencyID string
+	vsCurrency string
+}
+
+// firstQuotes drops quotes of markets already covered by previous provider
+// Marks markets of remaining quotes as covered
+func firstQuotes(covered map[market]bool, quotes []*entities.PriceQuote) []*entities.PriceQuote {
+	var first []*entities.PriceQuote
+	for _, quote := range quotes {
+		key := market{currencyID: quote.CurrencyID, vsCurrency: quote.VsCurrency}
+		if !covered[key] {
+			covered[key] = true
+			first = append(first, quote)
+		}
+	}
+	return first
+}
+
+// uncovered returns coins lacking quote in at least one quote currency
+// Such coins are requested again from next provider in failover order
+func (f *Fetcher) uncovered(coinIDs []string, covered map[market]bool) []string {
+	var rest []string
+	for _, coinID := range coinIDs {
+		for _, vs := range f.vsCurrencies {
+			if !covered[market{currencyID: coinID, vsCurrency: vs}] {
+				rest = append(rest, coinID)
+				break
+			}
+		}
+	}
+	return rest
+}
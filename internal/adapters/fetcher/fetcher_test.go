@@ -0,0 +1,295 @@
+package fetcher
+
+import (
+	"context"
+	"currencyhub/internal/adapters/apierr"
+	"currencyhub/internal/entities"
+	"currencyhub/internal/interfaces"
+	"currencyhub/internal/usecases"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeProvider returns deterministic quotes derived from coin position
+// Prices are multiplied by factor to simulate diverging sources
+type fakeProvider struct {
+	name   string
+	factor float64
+	err    error
+	skip   map[string]bool
+	vs     map[string]bool
+	calls  int
+}
+
+func (p *fakeProvider) Name() string {
+	if p.name == "" {
+		return "fake"
+	}
+	return p.name
+}
+
+func (p *fakeProvider) FetchPrices(ctx context.Context, coinIDs, vsCurrencies []string) ([]*entities.PriceQuote, error) {
+	p.calls++
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	observedAt := time.Date(2025, 1, 14, 12, 0, 0, 0, time.UTC)
+	var quotes []*entities.PriceQuote
+	var missing []string
+	for i, coinID := range coinIDs {
+		if p.skip[coinID] {
+			missing = append(missing, coinID)
+			continue
+		}
+		for _, vs := range vsCurrencies {
+			if p.vs != nil && !p.vs[vs] {
+				continue
+			}
+			quotes = append(quotes, &entities.PriceQuote{
+				CurrencyID: coinID,
+				VsCurrency: vs,
+				Price:      float64(i+1) * 100 * p.factorOrOne(),
+				Source:     p.Name(),
+				ObservedAt: observedAt,
+			})
+		}
+	}
+	if len(missing) > 0 {
+		return quotes, &apierr.PartialError{Provider: p.Name(), Missing: missing}
+	}
+	return quotes, nil
+}
+
+func (p *fakeProvider) factorOrOne() float64 {
+	if p.factor == 0 {
+		return 1
+	}
+	return p.factor
+}
+
+// recordingRepo captures saved ticks and published events, other repository methods are not used
+type recordingRepo struct {
+	interfaces.CurrencyRepository
+	ticks     []*entities.PriceTick
+	published []any
+	stale     []string
+	failFor   string
+}
+
+func (r *recordingRepo) Publish(event any) {
+	r.published = append(r.published, event)
+}
+
+func (r *recordingRepo) PublishWait(ctx context.Context, event any) error {
+	r.Publish(event)
+	return nil
+}
+
+func (r *recordingRepo) MarkStale(ctx context.Context, quote string, currencyIDs []string) error {
+	r.stale = append(r.stale, currencyIDs...)
+	return nil
+}
+
+func (r *recordingRepo) SavePrice(ctx context.Context, tick *entities.PriceTick) error {
+	if tick.CurrencyID == r.failFor {
+		return errors.New("database error")
+	}
+	r.ticks = append(r.ticks, tick)
+	return nil
+}
+
+// testCoins is tracked coin catalog used by fetcher tests
+var testCoins = []string{"bitcoin", "ethereum", "tether", "solana", "dogecoin"}
+
+// staticCoins serves fixed coin catalog
+type staticCoins struct {
+	interfaces.CoinRepository
+	ids []string
+}
+
+func (c staticCoins) GetCoins(ctx context.Context, enabledOnly bool) ([]*entities.Coin, error) {
+	coins := make([]*entities.Coin, 0, len(c.ids))
+	for _, id := range c.ids {
+		coins = append(coins, &entities.Coin{ID: id, Enabled: true})
+	}
+	return coins, nil
+}
+
+func newTestFetcher(repo *recordingRepo, providers ...interfaces.PriceProvider) *Fetcher {
+	return newMethodFetcher(MethodMedian, repo, providers...)
+}
+
+func newMethodFetcher(method string, repo *recordingRepo, providers ...interfaces.PriceProvider) *Fetcher {
+	return newQuoteFetcher(method, nil, repo, providers...)
+}
+
+func newQuoteFetcher(method string, vsCurrencies []string, repo *recordingRepo, providers ...interfaces.PriceProvider) *Fetcher {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	aggregator := Aggregator{Method: method, MaxDeviation: 5}
+	coins := usecase.NewCoinUseCase(staticCoins{ids: testCoins}, nil)
+	return NewFetcher(logger, providers, usecase.NewCurrencyUseCase(repo), coins, repo, aggregator, BreakerSettings{FailureThreshold: 1}, vsCurrencies, time.Minute)
+}
+
+func TestFetcher_Fetch_SavesAllQuotes(t *testing.T) {
+	provider := &fakeProvider{}
+	repo := &recordingRepo{}
+
+	err := newTestFetcher(repo, provider).Fetch(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, repo.ticks, len(testCoins))
+	assert.Equal(t, testCoins[0], repo.ticks[0].CurrencyID)
+	assert.Equal(t, 100.0, repo.ticks[0].Price)
+	assert.Equal(t, "fake", repo.ticks[0].Source)
+}
+
+func TestFetcher_Fetch_ProviderError(t *testing.T) {
+	provider := &fakeProvider{err: errors.New("timeout")}
+	repo := &recordingRepo{}
+
+	err := newTestFetcher(repo, provider).Fetch(context.Background())
+
+	assert.Error(t, err)
+	assert.Empty(t, repo.ticks)
+}
+
+func TestFetcher_Fetch_ReportsSaveErrors(t *testing.T) {
+	provider := &fakeProvider{}
+	repo := &recordingRepo{failFor: "ethereum"}
+
+	err := newTestFetcher(repo, provider).Fetch(context.Background())
+
+	assert.Error(t, err)
+	assert.Len(t, repo.ticks, len(testCoins)-1)
+	require.Len(t, repo.published, len(repo.ticks))
+	for i, tick := range repo.ticks {
+		assert.Equal(t, entities.PriceUpdated{Tick: tick}, repo.published[i])
+	}
+}
+
+func TestFetcher_Fetch_AggregatesSources(t *testing.T) {
+	repo := &recordingRepo{}
+	providers := []interfaces.PriceProvider{
+		&fakeProvider{name: "coingecko", factor: 1},
+		&fakeProvider{name: "binance", factor: 1.01},
+		&fakeProvider{name: "broken", factor: 10},
+	}
+
+	err := newTestFetcher(repo, providers...).Fetch(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, repo.ticks, len(testCoins))
+	tick := repo.ticks[0]
+	assert.InDelta(t, 100.5, tick.Price, 0.0001)
+	assert.Equal(t, MethodMedian, tick.Source)
+	require.Len(t, tick.Quotes, 3)
+	for _, quote := range tick.Quotes {
+		assert.Equal(t, quote.Source == "broken", quote.Excluded)
+	}
+}
+
+func TestFetcher_Fetch_SurvivesFailedProvider(t *testing.T) {
+	repo := &recordingRepo{}
+	providers := []interfaces.PriceProvider{
+		&fakeProvider{name: "coingecko", err: errors.New("timeout")},
+		&fakeProvider{name: "binance"},
+	}
+
+	err := newTestFetcher(repo, providers...).Fetch(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, repo.ticks, len(testCoins))
+	assert.Equal(t, "binance", repo.ticks[0].Source)
+}
+
+func TestFetcher_Fetch_FailoverUsesFirstAvailable(t *testing.T) {
+	repo := &recordingRepo{}
+	primary := &fakeProvider{name: "coingecko", err: errors.New("timeout")}
+	secondary := &fakeProvider{name: "binance"}
+	tertiary := &fakeProvider{name: "coinbase"}
+	fetcher := newMethodFetcher(MethodFailover, repo, primary, secondary, tertiary)
+
+	err := fetcher.Fetch(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "binance", repo.ticks[0].Source)
+	assert.Equal(t, 0, tertiary.calls)
+
+	err = fetcher.Fetch(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, primary.calls, "open circuit must skip primary provider")
+	assert.Equal(t, "open", fetcher.ProviderStatuses()[0].State)
+}
+
+func TestFetcher_Fetch_AllProvidersUnavailable(t *testing.T) {
+	repo := &recordingRepo{}
+	fetcher := newTestFetcher(repo, &fakeProvider{err: errors.New("timeout")})
+
+	err := fetcher.Fetch(context.Background())
+
+	assert.ErrorIs(t, err, ErrProvidersUnavailable)
+	delay := fetcher.nextDelay(err)
+	assert.GreaterOrEqual(t, delay, MinRetryDelay)
+	assert.LessOrEqual(t, delay, time.Minute)
+	assert.Equal(t, time.Minute, fetcher.nextDelay(nil))
+}
+
+func TestFetcher_Fetch_MarksMissingCoinsStale(t *testing.T) {
+	repo := &recordingRepo{}
+	provider := &fakeProvider{skip: map[string]bool{"tether": true}}
+
+	fetcher := newTestFetcher(repo, provider)
+
+	err := fetcher.Fetch(context.Background())
+
+	assert.ErrorIs(t, err, ErrStalePrices)
+	assert.Equal(t, []string{"tether"}, repo.stale)
+	assert.Len(t, repo.ticks, len(testCoins)-1)
+	assert.Equal(t, "closed", fetcher.ProviderStatuses()[0].State, "partial response is not a provider failure")
+}
+
+func TestFetcher_Fetch_FailoverFillsMissingCoins(t *testing.T) {
+	repo := &recordingRepo{}
+	primary := &fakeProvider{name: "coingecko", skip: map[string]bool{"tether": true}}
+	secondary := &fakeProvider{name: "binance"}
+
+	err := newMethodFetcher(MethodFailover, repo, primary, secondary).Fetch(context.Background())
+
+	require.NoError(t, err)
+	assert.Empty(t, repo.stale)
+	require.Len(t, repo.ticks, len(testCoins))
+	for _, tick := range repo.ticks {
+		if tick.CurrencyID == "tether" {
+			assert.Equal(t, "binance", tick.Source)
+		} else {
+			assert.Equal(t, "coingecko", tick.Source)
+		}
+	}
+}
+
+func TestFetcher_Fetch_SavesTickPerQuote(t *testing.T) {
+	repo := &recordingRepo{}
+	primary := &fakeProvider{name: "coingecko", vs: map[string]bool{"usd": true}}
+	secondary := &fakeProvider{name: "binance"}
+
+	err := newQuoteFetcher(MethodFailover, []string{"usd", "eur"}, repo, primary, secondary).Fetch(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, repo.ticks, 2*len(testCoins))
+	for _, tick := range repo.ticks {
+		if tick.Quote == "usd" {
+			assert.Equal(t, "coingecko", tick.Source)
+		} else {
+			assert.Equal(t, "eur", tick.Quote)
+			assert.Equal(t, "binance", tick.Source)
+		}
+	}
+}
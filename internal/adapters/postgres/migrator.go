@@ -1,80 +1,348 @@
 // Package postgres provides database schema migration functionality
-// Handles database structure creation and updates
+// Applies versioned migrations and records them in schema_migrations table
 package postgres
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"github.com/jmoiron/sqlx"
+	"io/fs"
 	"log/slog"
-	"os"
+	"path"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 )
 
+// migrationLockKey identifies advisory lock held while schema changes
+// Prevents concurrently booting replicas from applying migrations twice
+const migrationLockKey int64 = 7_236_174_903_215
+
+// ErrRollbackCancelled is returned when rollback plan is not confirmed
+var ErrRollbackCancelled = errors.New("rollback cancelled")
+
+// Migration represents single versioned schema change
+// Checksum of up script detects edits of already applied migrations
+type Migration struct {
+	Version  int64
+	Name     string
+	Up       string
+	Down     string
+	Checksum string
+}
+
+// AppliedMigration represents row of schema_migrations table
+type AppliedMigration struct {
+	Version   int64     `db:"version"`
+	Name      string    `db:"name"`
+	Checksum  string    `db:"checksum"`
+	AppliedAt time.Time `db:"applied_at"`
+}
+
 // Migrator manages database schema migrations
-// Handles application of SQL migration scripts
+// Handles application and rollback of versioned SQL scripts
 type Migrator struct {
-	db     *sqlx.DB
-	logger *slog.Logger
+	db         *sqlx.DB
+	logger     *slog.Logger
+	migrations []*Migration
 }
 
 // NewMigrator creates new database migrator instance
-// Initializes with database connection and logger
-func NewMigrator(db *sqlx.DB, logger *slog.Logger) *Migrator {
-	return &Migrator{
-		db:     db,
-		logger: logger,
+// Loads migrations from given file system, usually embedded migrations.FS
+func NewMigrator(db *sqlx.DB, logger *slog.Logger, fsys fs.FS) (*Migrator, error) {
+	migrations, err := LoadMigrations(fsys)
+	if err != nil {
+		return nil, err
 	}
+
+	return &Migrator{
+		db:         db,
+		logger:     logger,
+		migrations: migrations,
+	}, nil
 }
 
-// Migrate applies database schema migrations
-// Executes SQL scripts to create or update database structure
-func (m *Migrator) Migrate(ctx context.Context) error {
-	sqlBytes, err := os.ReadFile("migrations/migrations_up.sql")
+// LoadMigrations reads migration pairs from file system root
+// Returns migrations ordered by version, every one must have up and down script
+func LoadMigrations(fsys fs.FS) ([]*Migration, error) {
+	files, err := fs.Glob(fsys, "*.sql")
 	if err != nil {
-		return fmt.Errorf("failed to read migrations file: %w", err)
+		return nil, fmt.Errorf("failed to list migrations: %w", err)
 	}
 
-	queries := strings.Split(string(sqlBytes), ";")
+	byVersion := make(map[int64]*Migration)
+	for _, file := range files {
+		base := path.Base(file)
+		stem, direction, ok := strings.Cut(strings.TrimSuffix(base, ".sql"), ".")
+		if !ok || (direction != "up" && direction != "down") {
+			return nil, fmt.Errorf("invalid migration file name: %s", base)
+		}
+		number, name, ok := strings.Cut(stem, "_")
+		version, err := strconv.ParseInt(number, 10, 64)
+		if !ok || err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration version: %s", base)
+		}
+
+		content, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", base, err)
+		}
 
-	for _, query := range queries {
-		query = strings.TrimSpace(query)
-		if query == "" {
-			continue
+		migration, exists := byVersion[version]
+		if !exists {
+			migration = &Migration{Version: version, Name: name}
+			byVersion[version] = migration
+		}
+		if migration.Name != name {
+			return nil, fmt.Errorf("migration %d has conflicting names: %s and %s", version, migration.Name, name)
 		}
 
-		_, err := m.db.ExecContext(ctx, query)
+		if direction == "up" {
+			sum := sha256.Sum256(content)
+			migration.Up = string(content)
+			migration.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if strings.TrimSpace(migration.Up) == "" || strings.TrimSpace(migration.Down) == "" {
+			return nil, fmt.Errorf("migration %d_%s must have up and down scripts", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
+
+// Migrate applies pending migrations in version order
+// Each migration runs in own transaction together with its schema_migrations record
+func (m *Migrator) Migrate(ctx context.Context) error {
+	return m.withLock(ctx, func(conn *sqlx.Conn) error {
+		applied, err := m.applied(ctx, conn)
 		if err != nil {
-			return fmt.Errorf("failed to execute migration: %w\nQuery: %s", err, query)
+			return err
 		}
+		if err := m.verify(applied); err != nil {
+			return err
+		}
+
+		count := 0
+		for _, migration := range m.migrations {
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+
+			m.logger.Info("Applying migration", "version", migration.Version, "name", migration.Name)
+			err := m.inTx(ctx, conn, migration.Up, `INSERT INTO schema_migrations (version, name, checksum) VALUES ($1, $2, $3)`,
+				migration.Version, migration.Name, migration.Checksum)
+			if err != nil {
+				return fmt.Errorf("failed to apply migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+			count++
+		}
+
+		m.logger.Info("Migrations applied successfully", "applied", count, "version", m.latest())
+		return nil
+	})
+}
+
+// Status returns known migrations with time they were applied
+// Read only: takes no lock and does not create schema_migrations
+func (m *Migrator) Status(ctx context.Context) ([]*Migration, map[int64]time.Time, error) {
+	applied, err := m.appliedReadOnly(ctx)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	m.logger.Info("Migrations applied successfully")
-	return nil
+	appliedAt := make(map[int64]time.Time, len(applied))
+	for version, row := range applied {
+		appliedAt[version] = row.AppliedAt
+	}
+	return m.migrations, appliedAt, nil
 }
 
-// Rollback reverts database schema changes
-// Executes rollback scripts to undo migrations
-func (m *Migrator) Rollback(ctx context.Context, db *sqlx.DB, logger slog.Logger) error {
-	sqlBytes, err := os.ReadFile("migrations/migrations_up.sql")
+// RollbackPlan returns applied migrations that rollback to target would revert
+// Read only preview, Rollback plans again under lock before executing
+func (m *Migrator) RollbackPlan(ctx context.Context, target int64) ([]*Migration, error) {
+	applied, err := m.appliedReadOnly(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to read rollback file: %w", err)
+		return nil, err
 	}
+	return m.plan(applied, target)
+}
 
-	queries := strings.Split(string(sqlBytes), ";")
+// Rollback reverts applied migrations newer than target version
+// Plan is confirmed and executed under one lock, down scripts run newest first, each in own transaction
+func (m *Migrator) Rollback(ctx context.Context, target int64, confirm func(plan []*Migration) bool) error {
+	return m.withLock(ctx, func(conn *sqlx.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		plan, err := m.plan(applied, target)
+		if err != nil {
+			return err
+		}
+		if !confirm(plan) {
+			return ErrRollbackCancelled
+		}
+		if len(plan) == 0 {
+			return nil
+		}
 
-	for _, query := range queries {
-		query = strings.TrimSpace(query)
-		if query == "" {
-			continue
+		for _, migration := range plan {
+			m.logger.Info("Rolling back migration", "version", migration.Version, "name", migration.Name)
+			err := m.inTx(ctx, conn, migration.Down, `DELETE FROM schema_migrations WHERE version = $1`, migration.Version)
+			if err != nil {
+				return fmt.Errorf("failed to roll back migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
 		}
 
-		_, err := db.ExecContext(ctx, query)
-		if err != nil {
-			return fmt.Errorf("failed to execute rollback: %w\nQuery: %s", err, query)
+		m.logger.Info("Migrations rolled back successfully", "version", target)
+		return nil
+	})
+}
+
+// plan selects applied migrations newer than target, newest first
+// Target must be zero or version of known migration
+func (m *Migrator) plan(applied map[int64]*AppliedMigration, target int64) ([]*Migration, error) {
+	if target < 0 {
+		return nil, fmt.Errorf("invalid target version: %d", target)
+	}
+	if target > 0 && !m.known(target) {
+		return nil, fmt.Errorf("unknown target version: %d", target)
+	}
+
+	var plan []*Migration
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		migration := m.migrations[i]
+		if migration.Version <= target {
+			break
+		}
+		if _, ok := applied[migration.Version]; ok {
+			plan = append(plan, migration)
+		}
+	}
+	return plan, nil
+}
+
+// known checks that version belongs to loaded migration
+func (m *Migrator) known(version int64) bool {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+// withLock runs function on dedicated connection holding migration advisory lock
+// Creates schema_migrations table before running
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sqlx.Conn) error) error {
+	conn, err := m.db.Connx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, migrationLockKey); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer func() {
+		if _, err := conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, migrationLockKey); err != nil {
+			m.logger.Error("Failed to release migration lock", "error", err)
 		}
+	}()
+
+	query := `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version BIGINT PRIMARY KEY,
+		name TEXT NOT NULL,
+		checksum TEXT NOT NULL,
+		applied_at TIMESTAMP NOT NULL DEFAULT NOW()
+	)`
+	if _, err := conn.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	return fn(conn)
+}
+
+// appliedReadOnly reads applied migrations without lock
+// Missing schema_migrations table means nothing is applied
+func (m *Migrator) appliedReadOnly(ctx context.Context) (map[int64]*AppliedMigration, error) {
+	var exists bool
+	if err := m.db.GetContext(ctx, &exists, `SELECT to_regclass('schema_migrations') IS NOT NULL`); err != nil {
+		return nil, fmt.Errorf("failed to check schema_migrations table: %w", err)
+	}
+	if !exists {
+		return map[int64]*AppliedMigration{}, nil
+	}
+	return m.applied(ctx, m.db)
+}
+
+// applied reads already applied migrations keyed by version
+func (m *Migrator) applied(ctx context.Context, q sqlx.QueryerContext) (map[int64]*AppliedMigration, error) {
+	var rows []*AppliedMigration
+	query := `SELECT version, name, checksum, applied_at FROM schema_migrations ORDER BY version`
+	if err := sqlx.SelectContext(ctx, q, &rows, query); err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
 	}
 
-	logger.Info("Migrations rolled back successfully")
+	applied := make(map[int64]*AppliedMigration, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = row
+	}
+	return applied, nil
+}
+
+// verify checks that applied migrations were not edited or removed
+func (m *Migrator) verify(applied map[int64]*AppliedMigration) error {
+	known := make(map[int64]*Migration, len(m.migrations))
+	for _, migration := range m.migrations {
+		known[migration.Version] = migration
+	}
+
+	for version, row := range applied {
+		migration, ok := known[version]
+		if !ok {
+			return fmt.Errorf("applied migration %d_%s is missing from binary", version, row.Name)
+		}
+		if migration.Checksum != row.Checksum {
+			return fmt.Errorf("checksum mismatch for applied migration %d_%s", version, row.Name)
+		}
+	}
 	return nil
 }
+
+// inTx executes migration script and bookkeeping statement in one transaction
+func (m *Migrator) inTx(ctx context.Context, conn *sqlx.Conn, script, record string, args ...any) error {
+	tx, err := conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// latest returns version of newest known migration
+func (m *Migrator) latest() int64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
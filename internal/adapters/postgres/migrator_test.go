@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"currencyhub/migrations"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadMigrations_Embedded(t *testing.T) {
+	loaded, err := LoadMigrations(migrations.FS)
+
+	require.NoError(t, err)
+	require.NotEmpty(t, loaded)
+	for i, migration := range loaded {
+		assert.Equal(t, int64(i+1), migration.Version, "migration versions must be sequential")
+		assert.NotEmpty(t, migration.Checksum)
+	}
+}
+
+func TestLoadMigrations_Order(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0002_second.up.sql":   {Data: []byte("CREATE TABLE b (id INT)")},
+		"0002_second.down.sql": {Data: []byte("DROP TABLE b")},
+		"0001_first.up.sql":    {Data: []byte("CREATE TABLE a (id INT)")},
+		"0001_first.down.sql":  {Data: []byte("DROP TABLE a")},
+	}
+
+	loaded, err := LoadMigrations(fsys)
+
+	require.NoError(t, err)
+	require.Len(t, loaded, 2)
+	assert.Equal(t, "first", loaded[0].Name)
+	assert.Equal(t, "DROP TABLE b", loaded[1].Down)
+	assert.NotEqual(t, loaded[0].Checksum, loaded[1].Checksum)
+}
+
+func TestLoadMigrations_Invalid(t *testing.T) {
+	tests := map[string]fstest.MapFS{
+		"missing down": {
+			"0001_first.up.sql": {Data: []byte("CREATE TABLE a (id INT)")},
+		},
+		"bad version": {
+			"first.up.sql":   {Data: []byte("CREATE TABLE a (id INT)")},
+			"first.down.sql": {Data: []byte("DROP TABLE a")},
+		},
+		"bad direction": {
+			"0001_first.sideways.sql": {Data: []byte("SELECT 1")},
+		},
+		"conflicting names": {
+			"0001_first.up.sql":   {Data: []byte("CREATE TABLE a (id INT)")},
+			"0001_other.down.sql": {Data: []byte("DROP TABLE a")},
+		},
+	}
+
+	for name, fsys := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := LoadMigrations(fsys)
+			assert.Error(t, err)
+		})
+	}
+}
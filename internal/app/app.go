@@ -5,17 +5,26 @@ package app
 import (
 	"context"
 	"currencyhub/config"
+	"currencyhub/internal/adapters/binance"
+	"currencyhub/internal/adapters/coinbase"
 	"currencyhub/internal/adapters/coingecko"
+	"currencyhub/internal/adapters/fetcher"
 	"currencyhub/internal/adapters/postgres"
 	"currencyhub/internal/delivery/server"
 	"currencyhub/internal/delivery/telegram"
+	"currencyhub/internal/entities"
 	"currencyhub/internal/infrastructure/database"
+	"currencyhub/internal/infrastructure/eventbus"
 	log "currencyhub/internal/infrastructure/logger"
 	"currencyhub/internal/infrastructure/shutdown"
+	"currencyhub/internal/interfaces"
 	"currencyhub/internal/repository"
 	"currencyhub/internal/usecases"
+	"currencyhub/migrations"
+	"currencyhub/monitoring"
 	"fmt"
 	"github.com/jmoiron/sqlx"
+	"log/slog"
 	"net/http"
 	"time"
 )
@@ -32,46 +41,83 @@ func Run() error {
 
 	logger := log.SetupLogger(cfg.Logging.File)
 
-	dsn := database.LoadConfig(cfg)
-
-	var (
-		db   *sqlx.DB
-		err1 error
-	)
-	for i := 0; i < 5; i++ {
-		db, err1 = database.NewPostgresDB(dsn)
-		if err1 == nil {
-			break
-		}
-		logger.Warn("Failed to connect to database, retrying...", "attempt", i+1)
-		time.Sleep(5 * time.Second)
+	db, err := connectDB(cfg, logger)
+	if err != nil {
+		return err
 	}
 
-	migrator := postgres.NewMigrator(db, logger)
+	migrator, err := postgres.NewMigrator(db, logger, migrations.FS)
+	if err != nil {
+		return err
+	}
 	if err := migrator.Migrate(context.Background()); err != nil {
 		return err
 	}
 
 	currencyRepo := repository.NewCurrencyRepo(db)
 	userRepo := repository.NewUserService(db)
+	coinRepo := repository.NewCoinRepo(db)
+	alertRepo := repository.NewAlertRepo(db)
+	portfolioRepo := repository.NewPortfolioRepo(db)
+
+	bus := eventbus.New()
+	defer bus.Close()
+
+	quotes, err := entities.NewQuoteSet(cfg.Fetcher.Quotes)
+	if err != nil {
+		return err
+	}
 
 	currencyService := usecase.NewCurrencyUseCase(currencyRepo)
-	userService := usecase.NewUserUseCase(userRepo)
+	userService := usecase.NewUserUseCase(userRepo, quotes)
+	coinService := usecase.NewCoinUseCase(coinRepo, bus)
+	alertService := usecase.NewAlertUseCase(alertRepo, currencyRepo, quotes, bus, logger)
+	portfolioService := usecase.NewPortfolioUseCase(portfolioRepo, currencyRepo, quotes)
 
-	receiver := coingecko.NewClient(logger, cfg.Coingecko.APIKey, currencyService)
+	providers, err := newProviders(cfg, logger)
+	if err != nil {
+		return err
+	}
+	aggregator := fetcher.Aggregator{Method: cfg.Fetcher.Aggregation, MaxDeviation: cfg.Fetcher.MaxDeviation}
+	switch aggregator.Method {
+	case "":
+		aggregator.Method = fetcher.MethodMedian
+	case fetcher.MethodMedian, fetcher.MethodVWAP, fetcher.MethodFailover:
+	default:
+		return fmt.Errorf("unknown aggregation method: %s", aggregator.Method)
+	}
+	breaker := fetcher.BreakerSettings{
+		FailureThreshold: cfg.Fetcher.Breaker.FailureThreshold,
+		Cooldown:         cfg.Fetcher.Breaker.Cooldown,
+		MaxCooldown:      cfg.Fetcher.Breaker.MaxCooldown,
+	}
+	hub := server.NewHub(cfg.Server.MaxStreamClients, cfg.Server.StreamReplay)
+	go hub.Run(ctx, eventbus.Subscribe[entities.PriceUpdated](bus, "stream", eventbus.DefaultBuffer).C())
+
+	go monitoring.ObserveEvents(ctx,
+		eventbus.Subscribe[entities.PriceUpdated](bus, "metrics", eventbus.DefaultBuffer).C(),
+		eventbus.Subscribe[entities.FetchFailed](bus, "metrics", eventbus.DefaultBuffer).C(),
+		eventbus.Subscribe[entities.CoinAdded](bus, "metrics", eventbus.DefaultBuffer).C(),
+	)
+	go alertService.Run(ctx, eventbus.Subscribe[entities.PriceUpdated](bus, "alerts", eventbus.DefaultBuffer).C())
+
+	receiver := fetcher.NewFetcher(logger, providers, currencyService, coinService, bus, aggregator, breaker, quotes.Codes(), cfg.Fetcher.Interval)
 	go receiver.Run(ctx)
 
-	bot, err := telegram.NewBot(userService, currencyService, logger, cfg.Telegram.Token)
+	botPrices := eventbus.Subscribe[entities.PriceUpdated](bus, "telegram", eventbus.DefaultBuffer).C()
+	botAlerts := eventbus.Subscribe[entities.AlertTriggered](bus, "telegram", eventbus.DefaultBuffer).C()
+	bot, err := telegram.NewBot(userService, currencyService, coinService, alertService, portfolioService, botPrices, botAlerts, logger, cfg.Telegram.Token)
 	if err != nil {
 		return fmt.Errorf("telegram bot not created: %w", err)
 	}
 	go bot.Run(ctx)
 
-	handler := server.NewCurrencyHandler(currencyService)
+	handler := server.NewCurrencyHandler(currencyService, coinService, portfolioService, receiver, hub, quotes, logger, cfg.Server.AdminToken)
 	server := &http.Server{
 		Addr:    cfg.Server.Port,
 		Handler: handler.Routes(),
 	}
+	server.RegisterOnShutdown(hub.Close)
 
 	go func() {
 		logger.Info("Starting HTTP server", "port", cfg.Server.Port)
@@ -80,10 +126,49 @@ func Run() error {
 		}
 	}()
 
-	rollbackHook := func() error {
-		logger.Info("Executing database rollback during shutdown")
-		return migrator.Rollback(context.Background(), db, *logger)
+	return shutdown.WaitForShutdown(ctx, server, db, logger)
+}
+
+// connectDB opens PostgreSQL connection
+// Retries while database is starting up
+func connectDB(cfg *config.Config, logger *slog.Logger) (*sqlx.DB, error) {
+	dsn := database.LoadConfig(cfg)
+
+	var (
+		db  *sqlx.DB
+		err error
+	)
+	for i := 0; i < 5; i++ {
+		db, err = database.NewPostgresDB(dsn)
+		if err == nil {
+			return db, nil
+		}
+		logger.Warn("Failed to connect to database, retrying...", "attempt", i+1)
+		time.Sleep(5 * time.Second)
+	}
+	return nil, err
+}
+
+// newProviders creates price providers listed in configuration
+// Falls back to CoinGecko when no providers are configured
+func newProviders(cfg *config.Config, logger *slog.Logger) ([]interfaces.PriceProvider, error) {
+	names := cfg.Fetcher.Providers
+	if len(names) == 0 {
+		names = []string{"coingecko"}
 	}
 
-	return shutdown.WaitForShutdown(ctx, server, db, logger, rollbackHook)
+	providers := make([]interfaces.PriceProvider, 0, len(names))
+	for _, name := range names {
+		switch name {
+		case "coingecko":
+			providers = append(providers, coingecko.NewClient(logger, cfg.Coingecko.APIKey))
+		case "binance":
+			providers = append(providers, binance.NewClient(logger))
+		case "coinbase":
+			providers = append(providers, coinbase.NewClient(logger))
+		default:
+			return nil, fmt.Errorf("unknown price provider: %s", name)
+		}
+	}
+	return providers, nil
 }
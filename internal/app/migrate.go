@@ -0,0 +1,135 @@
+package app
+
+import (
+	"bufio"
+	"context"
+	"currencyhub/config"
+	"currencyhub/internal/adapters/postgres"
+	log "currencyhub/internal/infrastructure/logger"
+	"currencyhub/migrations"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// migrateUsage describes migrate subcommand
+const migrateUsage = `Usage: currencyhub migrate <command> [flags]
+
+Commands:
+  status                         show applied and pending migrations
+  up                             apply pending migrations
+  down --to <version> [--dry-run] [--yes]
+                                 roll back migrations newer than version, 0 reverts all`
+
+// Migrate runs database migration subcommand
+// Rollback is confirmed interactively by typing target version unless --yes is given
+func Migrate(args []string, stdin io.Reader, stdout io.Writer) error {
+	if len(args) == 0 {
+		fmt.Fprintln(stdout, migrateUsage)
+		return errors.New("migrate command is required")
+	}
+
+	command := args[0]
+	flags := flag.NewFlagSet("migrate "+command, flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	target := flags.Int64("to", -1, "target migration version to roll back to")
+	dryRun := flags.Bool("dry-run", false, "print SQL that would run without executing it")
+	yes := flags.Bool("yes", false, "skip confirmation prompt")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	switch command {
+	case "status", "up":
+	case "down":
+		if *target < 0 {
+			fmt.Fprintln(stdout, migrateUsage)
+			return errors.New("--to is required for down")
+		}
+	default:
+		fmt.Fprintln(stdout, migrateUsage)
+		return fmt.Errorf("unknown migrate command: %s", command)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+	logger := log.SetupLogger(cfg.Logging.File)
+
+	db, err := connectDB(cfg, logger)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	migrator, err := postgres.NewMigrator(db, logger, migrations.FS)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	switch command {
+	case "status":
+		return printStatus(ctx, migrator, stdout)
+	case "up":
+		return migrator.Migrate(ctx)
+	}
+
+	if *dryRun {
+		plan, err := migrator.RollbackPlan(ctx, *target)
+		if err != nil {
+			return err
+		}
+		if len(plan) == 0 {
+			fmt.Fprintf(stdout, "Nothing to roll back, schema is at or below version %d\n", *target)
+		}
+		for _, migration := range plan {
+			fmt.Fprintf(stdout, "-- %04d_%s (down)\n%s\n", migration.Version, migration.Name, strings.TrimSpace(migration.Down))
+		}
+		return nil
+	}
+
+	return migrator.Rollback(ctx, *target, func(plan []*postgres.Migration) bool {
+		if len(plan) == 0 {
+			fmt.Fprintf(stdout, "Nothing to roll back, schema is at or below version %d\n", *target)
+			return true
+		}
+		fmt.Fprintf(stdout, "Rolling back to version %d will revert:\n", *target)
+		for _, migration := range plan {
+			fmt.Fprintf(stdout, "  %04d_%s\n", migration.Version, migration.Name)
+		}
+		return *yes || confirm(stdin, stdout, strconv.FormatInt(*target, 10))
+	})
+}
+
+// confirm asks operator to type expected answer
+func confirm(stdin io.Reader, stdout io.Writer, expected string) bool {
+	fmt.Fprintf(stdout, "Data in reverted tables will be lost. Type %s to confirm: ", expected)
+	answer, err := bufio.NewReader(stdin).ReadString('\n')
+	if err != nil && answer == "" {
+		return false
+	}
+	return strings.TrimSpace(answer) == expected
+}
+
+// printStatus prints every known migration with its state
+func printStatus(ctx context.Context, migrator *postgres.Migrator, stdout io.Writer) error {
+	known, applied, err := migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range known {
+		state := "pending"
+		if at, ok := applied[migration.Version]; ok {
+			state = "applied " + at.UTC().Format(time.RFC3339)
+		}
+		fmt.Fprintf(stdout, "%04d_%s\t%s\n", migration.Version, migration.Name, state)
+	}
+	return nil
+}
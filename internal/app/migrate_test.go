@@ -0,0 +1,26 @@
+package app
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigrate_ValidatesArguments(t *testing.T) {
+	var out bytes.Buffer
+
+	assert.Error(t, Migrate(nil, strings.NewReader(""), &out))
+	assert.Error(t, Migrate([]string{"down"}, strings.NewReader(""), &out), "down without --to must be rejected")
+	assert.Error(t, Migrate([]string{"sideways"}, strings.NewReader(""), &out))
+	assert.Contains(t, out.String(), "Usage: currencyhub migrate")
+}
+
+func TestConfirm(t *testing.T) {
+	var out bytes.Buffer
+
+	assert.True(t, confirm(strings.NewReader("5\n"), &out, "5"))
+	assert.False(t, confirm(strings.NewReader("y\n"), &out, "5"))
+	assert.False(t, confirm(strings.NewReader(""), &out, "5"))
+}
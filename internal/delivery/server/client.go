@@ -1,10 +1,14 @@
 package server
 
 import (
+	"currencyhub/internal/entities"
+	"currencyhub/internal/interfaces"
 	"currencyhub/internal/usecases"
 	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	httpSwagger "github.com/swaggo/http-swagger"
+	"log/slog"
 	"net/http"
 )
 
@@ -12,28 +16,89 @@ import (
 // Contains business logic controller for currency operations
 type CurrencyHandler struct {
 	currencyUseCase *usecase.CurrencyUseCase
+	coinUseCase     *usecase.CoinUseCase
+	portfolio       *usecase.PortfolioUseCase
+	providers       interfaces.ProviderStatusReporter
+	hub             *Hub
+	quotes          entities.QuoteSet
+	logger          *slog.Logger
+	adminToken      string
 }
 
+// apiV1Prefix is path prefix of versioned REST API
+const apiV1Prefix = "/api/v1"
+
 // NewCurrencyHandler creates new CurrencyHandler instance
-// Initializes with currency use case dependency
-func NewCurrencyHandler(currencyUseCase *usecase.CurrencyUseCase) *CurrencyHandler {
-	return &CurrencyHandler{currencyUseCase: currencyUseCase}
+// Initializes with currency, coin and portfolio use cases, provider status, WebSocket hub, fetched quote currencies, logger and admin token
+func NewCurrencyHandler(currencyUseCase *usecase.CurrencyUseCase, coinUseCase *usecase.CoinUseCase, portfolio *usecase.PortfolioUseCase, providers interfaces.ProviderStatusReporter, hub *Hub, quotes entities.QuoteSet, logger *slog.Logger, adminToken string) *CurrencyHandler {
+	return &CurrencyHandler{
+		currencyUseCase: currencyUseCase,
+		coinUseCase:     coinUseCase,
+		portfolio:       portfolio,
+		providers:       providers,
+		hub:             hub,
+		quotes:          quotes,
+		logger:          logger,
+		adminToken:      adminToken,
+	}
 }
 
 // Routes configures HTTP routes for currency endpoints
-// Returns configured router with all currency endpoints
+// Serves API under /api/v1 and keeps unversioned paths as deprecated aliases
 func (h *CurrencyHandler) Routes() http.Handler {
 	r := chi.NewRouter()
 
+	r.Use(middleware.RequestID)
+	r.Use(requestIDHeaderMiddleware)
 	r.Use(prometheusMiddleware)
 
+	r.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		writeErrorResponse(w, r, http.StatusNotFound, codeNotFound, "route not found", nil)
+	})
+	r.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+		writeErrorResponse(w, r, http.StatusMethodNotAllowed, codeMethodNotAllowed, "method not allowed", nil)
+	})
+
 	r.Handle("/metrics", promhttp.Handler())
 
-	r.Get("/rates", h.GetRates)
-	r.Get("/rates/{currency}", h.GetCurrencyRate)
+	r.Get("/ws/rates", h.StreamRates)
+
+	r.Route(apiV1Prefix, func(r chi.Router) {
+		h.apiRoutes(r)
+		r.Get("/stream", h.StreamEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Use(deprecatedMiddleware)
+		h.apiRoutes(r)
+	})
 
 	r.Get("/swagger/*", httpSwagger.Handler(
 		httpSwagger.URL("doc.json"), // URL к сгенерированному файлу doc.json
 	))
 	return r
 }
+
+// apiRoutes registers REST API endpoints on given router
+func (h *CurrencyHandler) apiRoutes(r chi.Router) {
+	r.Use(varyAcceptMiddleware)
+
+	r.Get("/rates", h.GetRates)
+	r.Get("/rates/{currency}", h.GetCurrencyRate)
+	r.Get("/rates/{currency}/candles", h.GetCandles)
+	r.Get("/rates/{currency}/history", h.GetHistory)
+
+	r.Get("/coins", h.GetCoins)
+
+	r.Get("/status/providers", h.GetProviderStatus)
+
+	r.With(h.portfolioTokenMiddleware).Get("/portfolio", h.GetPortfolio)
+
+	r.Route("/admin", func(r chi.Router) {
+		r.Use(adminMiddleware(h.adminToken))
+		r.Get("/coins", h.GetAllCoins)
+		r.Post("/coins", h.AddCoin)
+		r.Post("/coins/{coin}/enable", h.EnableCoin)
+		r.Post("/coins/{coin}/disable", h.DisableCoin)
+		r.Post("/coins/{coin}/aliases", h.AddCoinAlias)
+	})
+}
@@ -0,0 +1,203 @@
+package server
+
+import (
+	"currencyhub/internal/entities"
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/chi/v5"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// GetCoins handles HTTP GET request for tracked coins
+// @Summary Получить список отслеживаемых монет
+// @Description Возвращает монеты каталога, цены которых собираются
+// @Tags coins
+// @Produce json,plain
+// @Success 200 {array} CoinResponse "Список монет"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Router /api/v1/coins [get]
+func (h *CurrencyHandler) GetCoins(w http.ResponseWriter, r *http.Request) {
+	coins, err := h.coinUseCase.GetCoins(r.Context())
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	h.writeCoins(w, r, coins)
+}
+
+// GetAllCoins handles HTTP GET request for whole coin catalog
+// @Summary Получить весь каталог монет
+// @Description Возвращает все монеты каталога, включая отключенные
+// @Tags admin
+// @Produce json,plain
+// @Param Authorization header string true "Bearer-токен администратора (ADMIN_TOKEN)"
+// @Success 200 {array} CoinResponse "Каталог монет"
+// @Failure 401 {object} ErrorResponse "Неверный токен администратора"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Router /api/v1/admin/coins [get]
+func (h *CurrencyHandler) GetAllCoins(w http.ResponseWriter, r *http.Request) {
+	coins, err := h.coinUseCase.GetAllCoins(r.Context())
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	h.writeCoins(w, r, coins)
+}
+
+// coinRequest is request body for adding coin to catalog
+type coinRequest struct {
+	ID     string `json:"id"`     // CoinGecko coin identifier
+	Symbol string `json:"symbol"` // Ticker symbol
+	Name   string `json:"name"`   // Human readable name
+}
+
+// AddCoin handles HTTP POST request adding coin to catalog
+// @Summary Добавить монету в каталог
+// @Description Добавляет монету, цены которой начнут собираться со следующего цикла обновления
+// @Tags admin
+// @Accept json
+// @Produce json,plain
+// @Param Authorization header string true "Bearer-токен администратора (ADMIN_TOKEN)"
+// @Param coin body coinRequest true "Монета: идентификатор CoinGecko, тикер и название"
+// @Success 201 {object} CoinResponse "Добавленная монета"
+// @Failure 400 {object} ErrorResponse "Неверные параметры запроса"
+// @Failure 401 {object} ErrorResponse "Неверный токен администратора"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Router /api/v1/admin/coins [post]
+func (h *CurrencyHandler) AddCoin(w http.ResponseWriter, r *http.Request) {
+	var req coinRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, r, &entities.ValidationError{Field: "body", Message: "invalid request body"})
+		return
+	}
+
+	coin := &entities.Coin{ID: req.ID, Symbol: req.Symbol, Name: req.Name}
+	if err := h.coinUseCase.AddCoin(r.Context(), coin); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	if wantsJSON(r) {
+		writeJSON(w, http.StatusCreated, newCoinResponse(coin))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(h.FormatCoin(coin)))
+}
+
+// EnableCoin handles HTTP POST request resuming coin tracking
+// @Summary Включить монету
+// @Tags admin
+// @Produce plain
+// @Param Authorization header string true "Bearer-токен администратора (ADMIN_TOKEN)"
+// @Param coin path string true "Идентификатор монеты"
+// @Success 204 {string} string "Монета включена"
+// @Failure 401 {object} ErrorResponse "Неверный токен администратора"
+// @Failure 404 {object} ErrorResponse "Монета не найдена"
+// @Router /api/v1/admin/coins/{coin}/enable [post]
+func (h *CurrencyHandler) EnableCoin(w http.ResponseWriter, r *http.Request) {
+	h.setCoinEnabled(w, r, true)
+}
+
+// DisableCoin handles HTTP POST request stopping coin tracking
+// @Summary Отключить монету
+// @Tags admin
+// @Produce plain
+// @Param Authorization header string true "Bearer-токен администратора (ADMIN_TOKEN)"
+// @Param coin path string true "Идентификатор монеты"
+// @Success 204 {string} string "Монета отключена"
+// @Failure 401 {object} ErrorResponse "Неверный токен администратора"
+// @Failure 404 {object} ErrorResponse "Монета не найдена"
+// @Router /api/v1/admin/coins/{coin}/disable [post]
+func (h *CurrencyHandler) DisableCoin(w http.ResponseWriter, r *http.Request) {
+	h.setCoinEnabled(w, r, false)
+}
+
+// aliasRequest is request body for adding coin alias
+type aliasRequest struct {
+	Alias string `json:"alias"` // Alternative coin name, e.g. matic
+}
+
+// AddCoinAlias handles HTTP POST request adding alternative coin name
+// @Summary Добавить псевдоним монеты
+// @Description Добавляет альтернативное название, по которому монету можно найти в запросах
+// @Tags admin
+// @Accept json
+// @Produce plain
+// @Param Authorization header string true "Bearer-токен администратора (ADMIN_TOKEN)"
+// @Param coin path string true "Идентификатор монеты"
+// @Param alias body aliasRequest true "Псевдоним"
+// @Success 204 {string} string "Псевдоним добавлен"
+// @Failure 400 {object} ErrorResponse "Неверные параметры запроса"
+// @Failure 401 {object} ErrorResponse "Неверный токен администратора"
+// @Failure 404 {object} ErrorResponse "Монета не найдена"
+// @Router /api/v1/admin/coins/{coin}/aliases [post]
+func (h *CurrencyHandler) AddCoinAlias(w http.ResponseWriter, r *http.Request) {
+	var req aliasRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, r, &entities.ValidationError{Field: "body", Message: "invalid request body"})
+		return
+	}
+
+	err := h.coinUseCase.AddAlias(r.Context(), req.Alias, chi.URLParam(r, "coin"))
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// setCoinEnabled switches tracking of coin from URL path
+func (h *CurrencyHandler) setCoinEnabled(w http.ResponseWriter, r *http.Request, enabled bool) {
+	coinID := chi.URLParam(r, "coin")
+
+	var err error
+	if enabled {
+		err = h.coinUseCase.EnableCoin(r.Context(), coinID)
+	} else {
+		err = h.coinUseCase.DisableCoin(r.Context(), coinID)
+	}
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeCoins writes coin list in negotiated response format
+func (h *CurrencyHandler) writeCoins(w http.ResponseWriter, r *http.Request, coins []*entities.Coin) {
+	if wantsJSON(r) {
+		response := make([]CoinResponse, 0, len(coins))
+		for _, coin := range coins {
+			response = append(response, newCoinResponse(coin))
+		}
+		writeJSON(w, http.StatusOK, response)
+		return
+	}
+
+	formattedCoins := make([]string, 0, len(coins))
+	for _, coin := range coins {
+		formattedCoins = append(formattedCoins, h.FormatCoin(coin))
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	response := strings.Join(formattedCoins, "\r\n\r\n")
+	w.Write([]byte(response))
+}
+
+// FormatCoin formats catalog coin for display
+// Returns formatted string with coin identifiers and tracking state
+func (h *CurrencyHandler) FormatCoin(coin *entities.Coin) string {
+	return fmt.Sprintf(
+		"ID: %s\r\nSymbol: %s\r\nName: %s\r\nEnabled: %t\r\nAddedAt: %s",
+		coin.ID,
+		coin.Symbol,
+		coin.Name,
+		coin.Enabled,
+		coin.AddedAt.UTC().Format(time.RFC3339),
+	)
+}
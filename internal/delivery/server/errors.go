@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"currencyhub/internal/entities"
+	"errors"
+	"github.com/go-chi/chi/v5/middleware"
+	"net/http"
+)
+
+// Error codes clients can switch on
+const (
+	codeInvalidArgument  = "invalid_argument"
+	codeUnsupportedQuote = "unsupported_quote"
+	codeCoinNotFound     = "coin_not_found"
+	codeCurrencyNotFound = "currency_not_found"
+	codeNotFound         = "not_found"
+	codeMethodNotAllowed = "method_not_allowed"
+	codeUnauthorized     = "unauthorized"
+	codeInternal         = "internal"
+)
+
+// internalErrorMessage replaces messages of unexpected errors
+const internalErrorMessage = "internal server error"
+
+// contextKey is type of request context keys set by server middleware
+type contextKey string
+
+// legacyRouteContextKey marks requests served by deprecated unversioned paths
+const legacyRouteContextKey = contextKey("legacy_route")
+
+// portfolioUserContextKey holds user authenticated by portfolio API token
+const portfolioUserContextKey = contextKey("portfolio_user")
+
+// ErrorResponse is error envelope returned by API
+type ErrorResponse struct {
+	Code      string         `json:"code" example:"coin_not_found"`
+	Message   string         `json:"message" example:"coin not found: bitcoinn, did you mean: bitcoin"`
+	RequestID string         `json:"request_id" example:"host/abcdef-000001"`
+	Details   map[string]any `json:"details,omitempty" swaggertype:"object"`
+}
+
+// writeError maps domain error to HTTP status and error envelope
+// Unknown errors are logged and reported without internal details
+func (h *CurrencyHandler) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	var notFound *entities.CoinNotFoundError
+	var invalid *entities.ValidationError
+
+	switch {
+	case errors.As(err, &notFound):
+		details := map[string]any{"query": notFound.Query}
+		if len(notFound.Suggestions) > 0 {
+			details["suggestions"] = notFound.Suggestions
+		}
+		writeErrorResponse(w, r, http.StatusNotFound, codeCoinNotFound, err.Error(), details)
+	case errors.Is(err, entities.ErrCoinNotFound):
+		writeErrorResponse(w, r, http.StatusNotFound, codeCoinNotFound, err.Error(), nil)
+	case errors.Is(err, entities.ErrCurrencyNotFound):
+		writeErrorResponse(w, r, http.StatusNotFound, codeCurrencyNotFound, err.Error(), nil)
+	case errors.Is(err, entities.ErrUnsupportedQuote):
+		details := map[string]any{"supported": h.quotes.Codes()}
+		writeErrorResponse(w, r, http.StatusBadRequest, codeUnsupportedQuote, err.Error(), details)
+	case errors.Is(err, entities.ErrInvalidToken):
+		writeErrorResponse(w, r, http.StatusUnauthorized, codeUnauthorized, err.Error(), nil)
+	case errors.As(err, &invalid):
+		details := map[string]any{"field": invalid.Field}
+		writeErrorResponse(w, r, http.StatusBadRequest, codeInvalidArgument, invalid.Message, details)
+	default:
+		h.logger.Error("Request failed", "method", r.Method, "path", r.URL.Path,
+			"request_id", middleware.GetReqID(r.Context()), "error", err)
+		writeErrorResponse(w, r, http.StatusInternalServerError, codeInternal, internalErrorMessage, nil)
+	}
+}
+
+// writeErrorResponse writes error envelope
+// Deprecated routes keep plain text errors unless client accepts JSON
+func writeErrorResponse(w http.ResponseWriter, r *http.Request, status int, code, message string, details map[string]any) {
+	if isLegacyRoute(r) && !wantsJSON(r) {
+		http.Error(w, message, status)
+		return
+	}
+	writeJSON(w, status, ErrorResponse{
+		Code:      code,
+		Message:   message,
+		RequestID: middleware.GetReqID(r.Context()),
+		Details:   details,
+	})
+}
+
+// isLegacyRoute reports whether request came through deprecated unversioned path
+func isLegacyRoute(r *http.Request) bool {
+	legacy, _ := r.Context().Value(legacyRouteContextKey).(bool)
+	return legacy
+}
+
+// withLegacyRoute marks request context as served by deprecated path
+func withLegacyRoute(ctx context.Context) context.Context {
+	return context.WithValue(ctx, legacyRouteContextKey, true)
+}
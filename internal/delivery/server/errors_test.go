@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"currencyhub/internal/entities"
+	"currencyhub/internal/interfaces"
+	"currencyhub/internal/usecases"
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type failingCurrencyRepository struct {
+	stubCurrencyRepository
+}
+
+func (f *failingCurrencyRepository) GetRates(ctx context.Context, quote string) ([]*entities.CurrencyRate, error) {
+	return nil, errors.New(`pq: relation "currencies" does not exist`)
+}
+
+func newTestRouter(repo interfaces.CurrencyRepository) http.Handler {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewCurrencyHandler(usecase.NewCurrencyUseCase(repo), nil, nil, nil, NewHub(0, 0), testQuotes, logger, "").Routes()
+}
+
+func decodeError(t *testing.T, w *httptest.ResponseRecorder) ErrorResponse {
+	t.Helper()
+	var response ErrorResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+	return response
+}
+
+func TestRoutes_V1ErrorEnvelope(t *testing.T) {
+	router := newTestRouter(&stubCurrencyRepository{})
+
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/rates?vs=xyz", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, r)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Header().Get("Content-Type"), mediaJSON)
+	assert.Empty(t, w.Header().Get("Deprecation"))
+
+	response := decodeError(t, w)
+	assert.Equal(t, codeUnsupportedQuote, response.Code)
+	assert.Equal(t, "unsupported quote currency: xyz", response.Message)
+	assert.NotEmpty(t, response.RequestID)
+	assert.Equal(t, w.Header().Get("X-Request-Id"), response.RequestID)
+	assert.Contains(t, response.Details["supported"], "usd")
+}
+
+func TestRoutes_QuoteNotFetched(t *testing.T) {
+	router := newTestRouter(&stubCurrencyRepository{})
+
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/rates?vs=gbp", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, r)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	response := decodeError(t, w)
+	assert.Equal(t, codeUnsupportedQuote, response.Code)
+	assert.ElementsMatch(t, []any{"usd", "eur", "rub", "btc"}, response.Details["supported"])
+}
+
+func TestRoutes_InternalErrorHidden(t *testing.T) {
+	router := newTestRouter(&failingCurrencyRepository{})
+
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/rates", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, r)
+
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	response := decodeError(t, w)
+	assert.Equal(t, codeInternal, response.Code)
+	assert.Equal(t, internalErrorMessage, response.Message)
+	assert.NotContains(t, response.Message, "pq:")
+}
+
+func TestRoutes_DeprecatedAlias(t *testing.T) {
+	router := newTestRouter(&stubCurrencyRepository{})
+
+	t.Run("success", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/rates", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "true", w.Header().Get("Deprecation"))
+		assert.Equal(t, `</api/v1/rates>; rel="successor-version"`, w.Header().Get("Link"))
+	})
+
+	t.Run("plain text error", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/rates?vs=xyz", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Header().Get("Content-Type"), mediaText)
+		assert.Equal(t, "unsupported quote currency: xyz\n", w.Body.String())
+	})
+
+	t.Run("json error when accepted", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/rates?vs=xyz", nil)
+		r.Header.Set("Accept", mediaJSON)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, codeUnsupportedQuote, decodeError(t, w).Code)
+	})
+}
+
+func TestWriteError_DomainErrors(t *testing.T) {
+	handler := NewCurrencyHandler(nil, nil, nil, nil, NewHub(0, 0), testQuotes, slog.New(slog.NewTextHandler(io.Discard, nil)), "")
+
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		code   string
+	}{
+		{"coin suggestions", &entities.CoinNotFoundError{Query: "bitcoinn", Suggestions: []string{"bitcoin"}}, http.StatusNotFound, codeCoinNotFound},
+		{"coin", entities.ErrCoinNotFound, http.StatusNotFound, codeCoinNotFound},
+		{"currency", entities.ErrCurrencyNotFound, http.StatusNotFound, codeCurrencyNotFound},
+		{"validation", &entities.ValidationError{Field: "to", Message: "invalid time range"}, http.StatusBadRequest, codeInvalidArgument},
+		{"api token", entities.ErrInvalidToken, http.StatusUnauthorized, codeUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/api/v1/rates/bitcoinn", nil)
+			w := httptest.NewRecorder()
+			handler.writeError(w, r, tt.err)
+
+			assert.Equal(t, tt.status, w.Code)
+			assert.Equal(t, tt.code, decodeError(t, w).Code)
+		})
+	}
+}
+
+func TestAdminMiddleware_RequiresBearer(t *testing.T) {
+	handler := adminMiddleware("secret")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	tests := map[string]int{
+		"Bearer secret": http.StatusNoContent,
+		"secret":        http.StatusUnauthorized,
+		"Bearer wrong":  http.StatusUnauthorized,
+		"":              http.StatusUnauthorized,
+	}
+	for header, status := range tests {
+		r := httptest.NewRequest(http.MethodPost, "/api/v1/admin/coins", nil)
+		r.Header.Set("Authorization", header)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+
+		assert.Equal(t, status, w.Code, header)
+	}
+}
@@ -7,22 +7,42 @@ import (
 	"fmt"
 	"github.com/go-chi/chi/v5"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // GetRates handles HTTP GET request for all currency rates
 // @Summary Получить все курсы валют
 // @Description Возвращает список всех доступных курсов криптовалют
+// @Description Формат ответа выбирается заголовком Accept: application/json или text/plain (по умолчанию)
 // @Tags rates
-// @Produce plain
-// @Success 200 {string} string "Курсы валют"
-// @Failure 500 {string} string "Внутренняя ошибка сервера"
-// @Router /rates [get]
+// @Produce json,plain
+// @Param vs query string false "Валюта котировки из настройки fetcher.quotes, например usd, eur, rub, btc" default(usd)
+// @Success 200 {array} RateResponse "Курсы валют"
+// @Failure 400 {object} ErrorResponse "Неподдерживаемая валюта котировки"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Router /api/v1/rates [get]
 func (h *CurrencyHandler) GetRates(w http.ResponseWriter, r *http.Request) {
+	quote, err := h.quoteParam(r)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
 	ctx := r.Context()
-	rates, err := h.currencyUseCase.GetRates(ctx)
+	rates, err := h.currencyUseCase.GetRates(ctx, quote)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		h.writeError(w, r, err)
+		return
+	}
+
+	if wantsJSON(r) {
+		response := make([]RateResponse, 0, len(rates))
+		for _, rate := range rates {
+			response = append(response, newRateResponse(rate))
+		}
+		writeJSON(w, http.StatusOK, response)
 		return
 	}
 
@@ -30,8 +50,8 @@ func (h *CurrencyHandler) GetRates(w http.ResponseWriter, r *http.Request) {
 	for _, rate := range rates {
 		formattedRate, err := h.FormatOutput(rate)
 		if err != nil {
-			http.Error(w, "Failed to format response", http.StatusInternalServerError)
-			continue
+			h.writeError(w, r, err)
+			return
 		}
 		formattedRates = append(formattedRates, formattedRate)
 	}
@@ -44,49 +64,273 @@ func (h *CurrencyHandler) GetRates(w http.ResponseWriter, r *http.Request) {
 // GetCurrencyRate handles HTTP GET request for specific currency rate
 // @Summary Получить курс конкретной валюты
 // @Description Возвращает детальную информацию по конкретной криптовалюте
+// @Description Формат ответа выбирается заголовком Accept: application/json или text/plain (по умолчанию)
 // @Tags rates
-// @Produce plain
-// @Param currency path string true "Идентификатор валюты"
-// @Success 200 {string} string "Данные по валюте"
-// @Failure 404 {string} string "Валюта не найдена"
-// @Failure 500 {string} string "Внутренняя ошибка сервера"
-// @Router /rates/{currency} [get]
+// @Produce json,plain
+// @Param currency path string true "Идентификатор, тикер или название валюты"
+// @Param vs query string false "Валюта котировки из настройки fetcher.quotes, например usd, eur, rub, btc" default(usd)
+// @Param breakdown query bool false "Показать котировки отдельных источников"
+// @Success 200 {object} RateResponse "Данные по валюте"
+// @Failure 400 {object} ErrorResponse "Неподдерживаемая валюта котировки"
+// @Failure 404 {object} ErrorResponse "Валюта не найдена"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Router /api/v1/rates/{currency} [get]
 func (h *CurrencyHandler) GetCurrencyRate(w http.ResponseWriter, r *http.Request) {
-	currencyID := chi.URLParam(r, "currency")
+	currencyID, ok := h.resolveCoin(w, r)
+	if !ok {
+		return
+	}
 
-	isExist := h.currencyUseCase.CheckList(currencyID)
-	if !isExist {
-		http.Error(w, "not found in the list", http.StatusNotFound)
+	quote, err := h.quoteParam(r)
+	if err != nil {
+		h.writeError(w, r, err)
 		return
 	}
 
 	ctx := r.Context()
-	rate, err := h.currencyUseCase.GetLatestByCurrency(ctx, currencyID)
+	rate, err := h.currencyUseCase.GetLatestByCurrency(ctx, currencyID, quote)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusNotFound)
+		h.writeError(w, r, err)
+		return
+	}
+
+	var quotes []*entities.PriceQuote
+	if breakdown, _ := strconv.ParseBool(r.URL.Query().Get("breakdown")); breakdown {
+		quotes, err = h.currencyUseCase.GetLatestQuotes(ctx, currencyID, quote)
+		if err != nil {
+			h.writeError(w, r, err)
+			return
+		}
+	}
+
+	if wantsJSON(r) {
+		response := newRateResponse(rate)
+		for _, quote := range quotes {
+			response.Sources = append(response.Sources, newQuoteResponse(quote))
+		}
+		writeJSON(w, http.StatusOK, response)
 		return
 	}
 
 	formattedRate, err := h.FormatOutput(rate)
 	if err != nil {
-		http.Error(w, "Failed to format response", http.StatusInternalServerError)
+		h.writeError(w, r, err)
 		return
 	}
+	for _, quote := range quotes {
+		formattedRate += "\r\n\r\n" + h.FormatQuote(quote)
+	}
 
 	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
 	w.Write([]byte(formattedRate))
 }
 
+// GetCandles handles HTTP GET request for OHLC candles of specific currency
+// @Summary Получить свечи (OHLC) по валюте
+// @Description Возвращает свечи open/high/low/close за период с заданным интервалом
+// @Tags rates
+// @Produce json,plain
+// @Param currency path string true "Идентификатор, тикер или название валюты"
+// @Param vs query string false "Валюта котировки из настройки fetcher.quotes, например usd, eur, rub, btc" default(usd)
+// @Param interval query string false "Интервал свечи: 1m, 5m, 1h, 1d" default(1h)
+// @Param from query string false "Начало периода (RFC3339 или unix-время)"
+// @Param to query string false "Конец периода (RFC3339 или unix-время)"
+// @Success 200 {array} CandleResponse "Свечи по валюте"
+// @Failure 400 {object} ErrorResponse "Неверные параметры запроса"
+// @Failure 404 {object} ErrorResponse "Валюта не найдена"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Router /api/v1/rates/{currency}/candles [get]
+func (h *CurrencyHandler) GetCandles(w http.ResponseWriter, r *http.Request) {
+	currencyID, ok := h.resolveCoin(w, r)
+	if !ok {
+		return
+	}
+
+	quote, err := h.quoteParam(r)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	interval := entities.Interval1h
+	if value := r.URL.Query().Get("interval"); value != "" {
+		interval = entities.CandleInterval(value)
+	}
+	if !interval.IsValid() {
+		h.writeError(w, r, &entities.ValidationError{Field: "interval", Message: fmt.Sprintf("unsupported candle interval: %s", interval)})
+		return
+	}
+
+	from, err := parseTimeParam(r.URL.Query().Get("from"))
+	if err != nil {
+		h.writeError(w, r, &entities.ValidationError{Field: "from", Message: "from must be RFC3339 or unix time"})
+		return
+	}
+	to, err := parseTimeParam(r.URL.Query().Get("to"))
+	if err != nil {
+		h.writeError(w, r, &entities.ValidationError{Field: "to", Message: "to must be RFC3339 or unix time"})
+		return
+	}
+
+	ctx := r.Context()
+	candles, err := h.currencyUseCase.GetCandles(ctx, currencyID, quote, interval, from, to)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	if wantsJSON(r) {
+		response := make([]CandleResponse, 0, len(candles))
+		for _, candle := range candles {
+			response = append(response, newCandleResponse(candle))
+		}
+		writeJSON(w, http.StatusOK, response)
+		return
+	}
+
+	formattedCandles := make([]string, 0, len(candles))
+	for _, candle := range candles {
+		formattedCandles = append(formattedCandles, h.FormatCandle(candle))
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	response := strings.Join(formattedCandles, "\r\n\r\n")
+	w.Write([]byte(response))
+}
+
+// GetProviderStatus handles HTTP GET request for price provider health
+// @Summary Получить состояние источников цен
+// @Description Возвращает состояние circuit breaker каждого источника цен в порядке приоритета
+// @Tags status
+// @Produce json,plain
+// @Success 200 {array} ProviderStatusResponse "Состояние источников"
+// @Router /api/v1/status/providers [get]
+func (h *CurrencyHandler) GetProviderStatus(w http.ResponseWriter, r *http.Request) {
+	statuses := h.providers.ProviderStatuses()
+
+	if wantsJSON(r) {
+		response := make([]ProviderStatusResponse, 0, len(statuses))
+		for _, status := range statuses {
+			response = append(response, newProviderStatusResponse(status))
+		}
+		writeJSON(w, http.StatusOK, response)
+		return
+	}
+
+	formattedStatuses := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		formattedStatuses = append(formattedStatuses, h.FormatProviderStatus(status))
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	response := strings.Join(formattedStatuses, "\r\n\r\n")
+	w.Write([]byte(response))
+}
+
+// resolveCoin resolves currency path parameter to tracked coin identifier
+// Writes not found response with suggestions when coin is unknown
+func (h *CurrencyHandler) resolveCoin(w http.ResponseWriter, r *http.Request) (string, bool) {
+	coin, err := h.coinUseCase.Resolve(r.Context(), chi.URLParam(r, "currency"))
+	if err != nil {
+		h.writeError(w, r, err)
+		return "", false
+	}
+	return coin.ID, true
+}
+
+// quoteParam reads quote currency from vs query parameter
+// Returns default quote when parameter is absent and ErrUnsupportedQuote for codes prices are not fetched in
+func (h *CurrencyHandler) quoteParam(r *http.Request) (string, error) {
+	value := r.URL.Query().Get("vs")
+	if value == "" {
+		return h.quotes.Default(), nil
+	}
+	quote, ok := h.quotes.Lookup(value)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", entities.ErrUnsupportedQuote, value)
+	}
+	return quote.Code, nil
+}
+
+// priceDecimals returns number of fraction digits for prices in quote currency
+func priceDecimals(code string) int {
+	if quote, ok := entities.LookupQuote(code); ok {
+		return quote.Decimals
+	}
+	return 2
+}
+
+// parseTimeParam parses query time value in RFC3339 or unix seconds format
+// Returns zero time for empty value
+func parseTimeParam(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0).UTC(), nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// FormatCandle formats OHLC candle for display
+// Returns formatted string with candle bucket and prices
+func (h *CurrencyHandler) FormatCandle(candle *entities.Candle) string {
+	decimals := priceDecimals(candle.Quote)
+	return fmt.Sprintf(
+		"OpenTime: %s\r\nOpen: %.*f\r\nHigh: %.*f\r\nLow: %.*f\r\nClose: %.*f\r\nTicks: %d",
+		candle.OpenTime.UTC().Format(time.RFC3339),
+		decimals, candle.Open,
+		decimals, candle.High,
+		decimals, candle.Low,
+		decimals, candle.Close,
+		candle.Ticks,
+	)
+}
+
+// FormatProviderStatus formats price provider health for display
+// Returns formatted string with circuit state and last error
+func (h *CurrencyHandler) FormatProviderStatus(status *entities.ProviderStatus) string {
+	formatted := fmt.Sprintf("Provider: %s\r\nState: %s\r\nFailures: %d", status.Name, status.State, status.Failures)
+	if !status.RetryAt.IsZero() {
+		formatted += "\r\nRetryAt: " + status.RetryAt.UTC().Format(time.RFC3339)
+	}
+	if status.LastError != "" {
+		formatted += "\r\nLastError: " + status.LastError
+	}
+	return formatted
+}
+
+// FormatQuote formats single source quote for display
+// Returns formatted string with source price and outlier status
+func (h *CurrencyHandler) FormatQuote(quote *entities.PriceQuote) string {
+	return fmt.Sprintf(
+		"Source: %s\r\nPrice: %.*f\r\nDeviation: %.2f%%\r\nExcluded: %t",
+		quote.Source,
+		priceDecimals(quote.VsCurrency), quote.Price,
+		quote.Deviation,
+		quote.Excluded,
+	)
+}
+
 // FormatOutput formats currency rate data for display
-// Returns formatted string with currency information
+// Returns formatted string with currency information, ChangePercent repeats Change1h for existing clients
+// Fields added later go to the end of record so positions of earlier ones do not shift
 func (h *CurrencyHandler) FormatOutput(rate *entities.CurrencyRate) (string, error) {
+	decimals := priceDecimals(rate.Quote)
 	formatted := fmt.Sprintf(
-		"CurrencyID: %s\r\nCurrentPrice: %.2f\r\nMinPrice: %.2f\r\nMaxPrice: %.2f\r\nChangePercent: %.2f%%",
+		"CurrencyID: %s\r\nCurrentPrice: %.*f\r\nMinPrice: %.*f\r\nMaxPrice: %.*f\r\nChangePercent: %.2f%%\r\nChange1h: %.2f%%\r\nChange24h: %.2f%%\r\nChange7d: %.2f%%\r\nQuote: %s",
 		rate.CurrencyID,
-		rate.CurrentPrice,
-		rate.MinPrice,
-		rate.MaxPrice,
-		rate.ChangePercent,
+		decimals, rate.CurrentPrice,
+		decimals, rate.MinPrice,
+		decimals, rate.MaxPrice,
+		rate.Change1h,
+		rate.Change1h,
+		rate.Change24h,
+		rate.Change7d,
+		rate.Quote,
 	)
+	if rate.Stale {
+		formatted += "\r\nStale: true"
+	}
 	return formatted, nil
 }
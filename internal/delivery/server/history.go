@@ -0,0 +1,182 @@
+package server
+
+import (
+	"currencyhub/internal/entities"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// rawHistoryStep selects raw price observations instead of downsampled series
+const rawHistoryStep = "raw"
+
+// GetHistory handles HTTP GET request for price history of specific currency
+// @Summary Получить историю цен по валюте
+// @Description Возвращает временной ряд сохраненных наблюдений цены. По умолчанию ряд прореживается до 500 точек (средняя, минимальная и максимальная цена в каждом шаге).
+// @Description С параметром step=raw возвращаются исходные наблюдения постранично: курсор следующей страницы передается в параметре cursor
+// @Tags rates
+// @Produce json,plain
+// @Param currency path string true "Идентификатор, тикер или название валюты"
+// @Param vs query string false "Валюта котировки из настройки fetcher.quotes, например usd, eur, rub, btc" default(usd)
+// @Param from query string false "Начало периода (RFC3339 или unix-время), по умолчанию сутки назад"
+// @Param to query string false "Конец периода (RFC3339 или unix-время), по умолчанию текущее время"
+// @Param step query string false "Шаг ряда (5m, 1h или секунды) либо raw для исходных наблюдений"
+// @Param cursor query string false "Курсор страницы исходных наблюдений"
+// @Param limit query int false "Размер страницы исходных наблюдений (до 1000)" default(500)
+// @Success 200 {object} HistoryResponse "История цен: points для прореженного ряда, ticks и next_cursor для step=raw"
+// @Failure 400 {object} ErrorResponse "Неверные параметры запроса"
+// @Failure 404 {object} ErrorResponse "Валюта не найдена"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Router /api/v1/rates/{currency}/history [get]
+func (h *CurrencyHandler) GetHistory(w http.ResponseWriter, r *http.Request) {
+	currencyID, ok := h.resolveCoin(w, r)
+	if !ok {
+		return
+	}
+
+	quote, err := h.quoteParam(r)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	query := r.URL.Query()
+	from, err := parseTimeParam(query.Get("from"))
+	if err != nil {
+		h.writeError(w, r, &entities.ValidationError{Field: "from", Message: "from must be RFC3339 or unix time"})
+		return
+	}
+	to, err := parseTimeParam(query.Get("to"))
+	if err != nil {
+		h.writeError(w, r, &entities.ValidationError{Field: "to", Message: "to must be RFC3339 or unix time"})
+		return
+	}
+
+	if query.Get("step") == rawHistoryStep {
+		h.writeTickPage(w, r, currencyID, quote, from, to)
+		return
+	}
+
+	step, err := parseStepParam(query.Get("step"))
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	points, step, err := h.currencyUseCase.GetHistory(r.Context(), currencyID, quote, from, to, step)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	if wantsJSON(r) {
+		response := HistoryResponse{
+			CurrencyID: currencyID,
+			Quote:      quote,
+			Step:       step.String(),
+			Points:     make([]HistoryPointResponse, 0, len(points)),
+		}
+		for _, point := range points {
+			response.Points = append(response.Points, newHistoryPointResponse(point))
+		}
+		writeJSON(w, http.StatusOK, response)
+		return
+	}
+
+	formattedPoints := make([]string, 0, len(points)+1)
+	formattedPoints = append(formattedPoints, fmt.Sprintf("CurrencyID: %s\r\nQuote: %s\r\nStep: %s", currencyID, quote, step))
+	for _, point := range points {
+		formattedPoints = append(formattedPoints, h.FormatHistoryPoint(point, quote))
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Write([]byte(strings.Join(formattedPoints, "\r\n\r\n")))
+}
+
+// writeTickPage writes page of raw price observations selected by cursor and limit
+func (h *CurrencyHandler) writeTickPage(w http.ResponseWriter, r *http.Request, currencyID, quote string, from, to time.Time) {
+	limit := 0
+	if value := r.URL.Query().Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			h.writeError(w, r, &entities.ValidationError{Field: "limit", Message: "limit must be integer"})
+			return
+		}
+		limit = parsed
+	}
+
+	page, err := h.currencyUseCase.GetHistoryPage(r.Context(), currencyID, quote, from, to, r.URL.Query().Get("cursor"), limit)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	if wantsJSON(r) {
+		response := HistoryResponse{
+			CurrencyID: currencyID,
+			Quote:      quote,
+			Step:       rawHistoryStep,
+			Ticks:      make([]TickResponse, 0, len(page.Ticks)),
+			NextCursor: page.NextCursor,
+		}
+		for _, tick := range page.Ticks {
+			response.Ticks = append(response.Ticks, newTickResponse(tick))
+		}
+		writeJSON(w, http.StatusOK, response)
+		return
+	}
+
+	formattedTicks := make([]string, 0, len(page.Ticks)+1)
+	for _, tick := range page.Ticks {
+		formattedTicks = append(formattedTicks, h.FormatTick(tick))
+	}
+	if page.NextCursor != "" {
+		formattedTicks = append(formattedTicks, "NextCursor: "+page.NextCursor)
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Write([]byte(strings.Join(formattedTicks, "\r\n\r\n")))
+}
+
+// parseStepParam parses history step as duration or whole seconds
+// Returns zero step for empty value so that it is chosen automatically
+func parseStepParam(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	step, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, &entities.ValidationError{Field: "step", Message: fmt.Sprintf("invalid step: %q", value)}
+	}
+	return step, nil
+}
+
+// FormatHistoryPoint formats downsampled history point for display
+// Returns formatted string with bucket time and price bounds
+func (h *CurrencyHandler) FormatHistoryPoint(point *entities.HistoryPoint, quote string) string {
+	decimals := priceDecimals(quote)
+	return fmt.Sprintf(
+		"Time: %s\r\nPrice: %.*f\r\nMinPrice: %.*f\r\nMaxPrice: %.*f\r\nTicks: %d",
+		point.Time.UTC().Format(time.RFC3339),
+		decimals, point.Price,
+		decimals, point.MinPrice,
+		decimals, point.MaxPrice,
+		point.Ticks,
+	)
+}
+
+// FormatTick formats raw price observation for display
+// Returns formatted string with observation time, price and source
+func (h *CurrencyHandler) FormatTick(tick *entities.PriceTick) string {
+	return fmt.Sprintf(
+		"ObservedAt: %s\r\nPrice: %.*f\r\nSource: %s",
+		tick.ObservedAt.UTC().Format(time.RFC3339),
+		priceDecimals(tick.Quote), tick.Price,
+		tick.Source,
+	)
+}
@@ -0,0 +1,29 @@
+package server
+
+import (
+	"currencyhub/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestParseStepParam(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"", 0},
+		{"300", 5 * time.Minute},
+		{"5m", 5 * time.Minute},
+		{"1h30m", 90 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		step, err := parseStepParam(tt.value)
+		assert.NoError(t, err, tt.value)
+		assert.Equal(t, tt.want, step, tt.value)
+	}
+
+	_, err := parseStepParam("weekly")
+	assert.ErrorIs(t, err, entities.ErrInvalidArgument)
+}
@@ -1,10 +1,17 @@
 package server
 
 import (
+	"bufio"
+	"context"
+	"crypto/subtle"
 	"currencyhub/monitoring"
+	"fmt"
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/prometheus/client_golang/prometheus"
+	"net"
 	"net/http"
 	"strconv"
+	"strings"
 )
 
 type responseWriterWrapper struct {
@@ -17,6 +24,21 @@ func (w *responseWriterWrapper) WriteHeader(code int) {
 	w.ResponseWriter.WriteHeader(code)
 }
 
+// Unwrap exposes underlying writer to http.ResponseController
+// Needed by WebSocket upgrade and streaming responses
+func (w *responseWriterWrapper) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// Hijack lets WebSocket upgrader take over connection
+func (w *responseWriterWrapper) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, rw, err := http.NewResponseController(w.ResponseWriter).Hijack()
+	if err == nil {
+		w.statusCode = http.StatusSwitchingProtocols
+	}
+	return conn, rw, err
+}
+
 func prometheusMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		wrappedWriter := &responseWriterWrapper{ResponseWriter: w, statusCode: http.StatusOK}
@@ -33,3 +55,69 @@ func prometheusMiddleware(next http.Handler) http.Handler {
 		).Inc()
 	})
 }
+
+// adminMiddleware restricts access to requests bearing admin token
+// Admin routes are disabled when no token is configured
+func adminMiddleware(token string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if token == "" {
+				writeErrorResponse(w, r, http.StatusNotFound, codeNotFound, "admin API disabled", nil)
+				return
+			}
+			provided, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+				writeErrorResponse(w, r, http.StatusUnauthorized, codeUnauthorized, "invalid admin token", nil)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// portfolioTokenMiddleware authenticates requests bearing portfolio API token
+// Stores owner of token in request context
+func (h *CurrencyHandler) portfolioTokenMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok {
+			token = ""
+		}
+		userID, err := h.portfolio.Authenticate(r.Context(), token)
+		if err != nil {
+			h.writeError(w, r, err)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), portfolioUserContextKey, userID)))
+	})
+}
+
+// requestIDHeaderMiddleware echoes request identifier in response header
+// Lets clients correlate error envelopes with server logs
+func requestIDHeaderMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if id := middleware.GetReqID(r.Context()); id != "" {
+			w.Header().Set(middleware.RequestIDHeader, id)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// varyAcceptMiddleware marks responses as negotiated by Accept header
+// Both JSON and text representations carry it so that caches keep them apart
+func varyAcceptMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept")
+		next.ServeHTTP(w, r)
+	})
+}
+
+// deprecatedMiddleware marks unversioned routes as deprecated aliases
+// Points clients to matching /api/v1 path via Link header
+func deprecatedMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Deprecation", "true")
+		w.Header().Set("Link", fmt.Sprintf("<%s%s>; rel=\"successor-version\"", apiV1Prefix, r.URL.Path))
+		next.ServeHTTP(w, r.WithContext(withLegacyRoute(r.Context())))
+	})
+}
@@ -0,0 +1,81 @@
+package server
+
+import (
+	"currencyhub/internal/entities"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GetPortfolio handles HTTP GET request for portfolio of token owner
+// @Summary Получить портфель пользователя
+// @Description Возвращает позиции и итоги портфеля, оцененные по последним сохраненным курсам. Токен выдается командой /token в Telegram-боте
+// @Tags portfolio
+// @Produce json,plain
+// @Param Authorization header string true "Bearer-токен портфеля"
+// @Success 200 {object} PortfolioResponse "Портфель"
+// @Failure 401 {object} ErrorResponse "Неверный токен портфеля"
+// @Failure 500 {object} ErrorResponse "Внутренняя ошибка сервера"
+// @Router /api/v1/portfolio [get]
+func (h *CurrencyHandler) GetPortfolio(w http.ResponseWriter, r *http.Request) {
+	userID, _ := r.Context().Value(portfolioUserContextKey).(int64)
+
+	portfolio, err := h.portfolio.GetPortfolio(r.Context(), userID)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	if wantsJSON(r) {
+		writeJSON(w, http.StatusOK, newPortfolioResponse(portfolio))
+		return
+	}
+
+	formatted := make([]string, 0, len(portfolio.Positions)+len(portfolio.Totals))
+	for _, position := range portfolio.Positions {
+		formatted = append(formatted, h.FormatPosition(position))
+	}
+	for _, total := range portfolio.Totals {
+		formatted = append(formatted, h.FormatPortfolioTotal(total))
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	response := strings.Join(formatted, "\r\n\r\n")
+	w.Write([]byte(response))
+}
+
+// FormatPosition formats portfolio position for display
+// Returns formatted string with holding, cost and profit/loss
+func (h *CurrencyHandler) FormatPosition(position *entities.Position) string {
+	decimals := priceDecimals(position.Quote)
+	currentPrice, value, unrealized := "n/a", "n/a", "n/a"
+	if position.Priced {
+		currentPrice = fmt.Sprintf("%.*f", decimals, position.CurrentPrice)
+		value = fmt.Sprintf("%.2f", position.Value)
+		unrealized = fmt.Sprintf("%.2f", position.UnrealizedPnL)
+	}
+	return fmt.Sprintf(
+		"CurrencyID: %s\r\nQuote: %s\r\nAmount: %g\r\nAveragePrice: %.*f\r\nCostBasis: %.2f\r\nCurrentPrice: %s\r\nValue: %s\r\nRealizedPnL: %.2f\r\nUnrealizedPnL: %s",
+		position.CurrencyID,
+		position.Quote,
+		position.Amount,
+		decimals, position.AveragePrice,
+		position.CostBasis,
+		currentPrice,
+		value,
+		position.RealizedPnL,
+		unrealized,
+	)
+}
+
+// FormatPortfolioTotal formats portfolio totals in one quote currency for display
+func (h *CurrencyHandler) FormatPortfolioTotal(total *entities.PortfolioTotal) string {
+	return fmt.Sprintf(
+		"Total: %s\r\nValue: %.2f\r\nCostBasis: %.2f\r\nRealizedPnL: %.2f\r\nUnrealizedPnL: %.2f",
+		total.Quote,
+		total.Value,
+		total.CostBasis,
+		total.RealizedPnL,
+		total.UnrealizedPnL,
+	)
+}
@@ -0,0 +1,324 @@
+package server
+
+import (
+	"currencyhub/internal/entities"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Media types supported by content negotiation
+const (
+	mediaJSON = "application/json"
+	mediaText = "text/plain"
+)
+
+// RateResponse is JSON representation of currency rate
+type RateResponse struct {
+	CurrencyID    string          `json:"currency_id" example:"bitcoin"`
+	Quote         string          `json:"quote" example:"usd"`
+	CurrentPrice  float64         `json:"current_price" example:"97012.5"`
+	MinPrice      float64         `json:"min_price" example:"95400"`
+	MaxPrice      float64         `json:"max_price" example:"97830.1"`
+	Change1h      float64         `json:"change_1h" example:"0.42"`
+	ChangePercent float64         `json:"change_percent" example:"0.42"` // Deprecated: same as change_1h, kept for existing clients
+	Change24h     float64         `json:"change_24h" example:"-1.37"`
+	Change7d      float64         `json:"change_7d" example:"4.8"`
+	HourMinPrice  float64         `json:"hour_min_price" example:"96800"`
+	HourMaxPrice  float64         `json:"hour_max_price" example:"97210"`
+	Timestamp     time.Time       `json:"timestamp" example:"2025-01-14T12:05:00Z"`
+	Date          string          `json:"date" example:"2025-01-14"`
+	Stale         bool            `json:"stale" example:"false"`
+	Sources       []QuoteResponse `json:"sources,omitempty"`
+}
+
+// QuoteResponse is JSON representation of single source quote
+type QuoteResponse struct {
+	Source           string    `json:"source" example:"binance"`
+	Price            float64   `json:"price" example:"97020.3"`
+	Volume           float64   `json:"volume" example:"1523.7"`
+	DeviationPercent float64   `json:"deviation_percent" example:"0.01"`
+	Excluded         bool      `json:"excluded" example:"false"`
+	ObservedAt       time.Time `json:"observed_at" example:"2025-01-14T12:05:00Z"`
+}
+
+// PriceUpdateResponse is JSON representation of streamed price update
+type PriceUpdateResponse struct {
+	CurrencyID string    `json:"currency_id" example:"bitcoin"`
+	Quote      string    `json:"quote" example:"usd"`
+	Price      float64   `json:"price" example:"97012.5"`
+	ObservedAt time.Time `json:"observed_at" example:"2025-01-14T12:05:00Z"`
+	Source     string    `json:"source" example:"median"`
+}
+
+// CandleResponse is JSON representation of OHLC candle
+type CandleResponse struct {
+	CurrencyID string    `json:"currency_id" example:"bitcoin"`
+	Quote      string    `json:"quote" example:"usd"`
+	Interval   string    `json:"interval" example:"1h"`
+	OpenTime   time.Time `json:"open_time" example:"2025-01-14T12:00:00Z"`
+	Open       float64   `json:"open" example:"96950"`
+	High       float64   `json:"high" example:"97210"`
+	Low        float64   `json:"low" example:"96800"`
+	Close      float64   `json:"close" example:"97012.5"`
+	Ticks      int64     `json:"ticks" example:"12"`
+}
+
+// HistoryResponse is JSON representation of price history
+// Points hold downsampled series, Ticks hold raw observations page for step=raw
+type HistoryResponse struct {
+	CurrencyID string                 `json:"currency_id" example:"bitcoin"`
+	Quote      string                 `json:"quote" example:"usd"`
+	Step       string                 `json:"step" example:"5m0s"`
+	Points     []HistoryPointResponse `json:"points,omitempty"`
+	Ticks      []TickResponse         `json:"ticks,omitempty"`
+	NextCursor string                 `json:"next_cursor,omitempty" example:"MTczNjg1NjMwMDAwMDAwMDAwMC40Mg"`
+}
+
+// HistoryPointResponse is JSON representation of downsampled history point
+type HistoryPointResponse struct {
+	Time     time.Time `json:"time" example:"2025-01-14T12:00:00Z"`
+	Price    float64   `json:"price" example:"97012.5"`
+	MinPrice float64   `json:"min_price" example:"96800"`
+	MaxPrice float64   `json:"max_price" example:"97210"`
+	Ticks    int64     `json:"ticks" example:"5"`
+}
+
+// TickResponse is JSON representation of raw price observation
+type TickResponse struct {
+	Price      float64   `json:"price" example:"97012.5"`
+	ObservedAt time.Time `json:"observed_at" example:"2025-01-14T12:05:00Z"`
+	Source     string    `json:"source" example:"median"`
+}
+
+// ProviderStatusResponse is JSON representation of price provider health
+type ProviderStatusResponse struct {
+	Name      string     `json:"name" example:"coingecko"`
+	State     string     `json:"state" example:"closed"`
+	Failures  int        `json:"failures" example:"0"`
+	LastError string     `json:"last_error,omitempty"`
+	RetryAt   *time.Time `json:"retry_at,omitempty"`
+}
+
+// CoinResponse is JSON representation of catalog coin
+type CoinResponse struct {
+	ID      string    `json:"id" example:"bitcoin"`
+	Symbol  string    `json:"symbol" example:"BTC"`
+	Name    string    `json:"name" example:"Bitcoin"`
+	Enabled bool      `json:"enabled" example:"true"`
+	AddedAt time.Time `json:"added_at" example:"2025-01-14T12:00:00Z"`
+}
+
+// PortfolioResponse is JSON representation of user portfolio
+type PortfolioResponse struct {
+	Positions []PositionResponse       `json:"positions"`
+	Totals    []PortfolioTotalResponse `json:"totals"`
+}
+
+// PositionResponse is JSON representation of single coin holding
+// Current price, value and unrealized P&L are omitted when coin has no saved rate
+type PositionResponse struct {
+	CurrencyID    string   `json:"currency_id" example:"bitcoin"`
+	Quote         string   `json:"quote" example:"usd"`
+	Amount        float64  `json:"amount" example:"0.5"`
+	CostBasis     float64  `json:"cost_basis" example:"31000"`
+	AveragePrice  float64  `json:"average_price" example:"62000"`
+	CurrentPrice  *float64 `json:"current_price,omitempty" example:"97012.5"`
+	Value         *float64 `json:"value,omitempty" example:"48506.25"`
+	RealizedPnL   float64  `json:"realized_pnl" example:"0"`
+	UnrealizedPnL *float64 `json:"unrealized_pnl,omitempty" example:"17506.25"`
+}
+
+// PortfolioTotalResponse is JSON representation of portfolio totals in one quote currency
+type PortfolioTotalResponse struct {
+	Quote         string  `json:"quote" example:"usd"`
+	Value         float64 `json:"value" example:"48506.25"`
+	CostBasis     float64 `json:"cost_basis" example:"31000"`
+	RealizedPnL   float64 `json:"realized_pnl" example:"0"`
+	UnrealizedPnL float64 `json:"unrealized_pnl" example:"17506.25"`
+}
+
+// newRateResponse converts currency rate to its JSON representation
+func newRateResponse(rate *entities.CurrencyRate) RateResponse {
+	return RateResponse{
+		CurrencyID:    rate.CurrencyID,
+		Quote:         rate.Quote,
+		CurrentPrice:  rate.CurrentPrice,
+		MinPrice:      rate.MinPrice,
+		MaxPrice:      rate.MaxPrice,
+		Change1h:      rate.Change1h,
+		ChangePercent: rate.Change1h,
+		Change24h:     rate.Change24h,
+		Change7d:      rate.Change7d,
+		HourMinPrice:  rate.HourMinPrice,
+		HourMaxPrice:  rate.HourMaxPrice,
+		Timestamp:     rate.TimeStamp.UTC(),
+		Date:          rate.Date.Format(time.DateOnly),
+		Stale:         rate.Stale,
+	}
+}
+
+// newQuoteResponse converts source quote to its JSON representation
+func newQuoteResponse(quote *entities.PriceQuote) QuoteResponse {
+	return QuoteResponse{
+		Source:           quote.Source,
+		Price:            quote.Price,
+		Volume:           quote.Volume,
+		DeviationPercent: quote.Deviation,
+		Excluded:         quote.Excluded,
+		ObservedAt:       quote.ObservedAt.UTC(),
+	}
+}
+
+// newPriceUpdateResponse converts saved price observation to streamed update
+func newPriceUpdateResponse(tick *entities.PriceTick) PriceUpdateResponse {
+	return PriceUpdateResponse{
+		CurrencyID: tick.CurrencyID,
+		Quote:      tick.Quote,
+		Price:      tick.Price,
+		ObservedAt: tick.ObservedAt.UTC(),
+		Source:     tick.Source,
+	}
+}
+
+// newCandleResponse converts candle to its JSON representation
+func newCandleResponse(candle *entities.Candle) CandleResponse {
+	return CandleResponse{
+		CurrencyID: candle.CurrencyID,
+		Quote:      candle.Quote,
+		Interval:   string(candle.Interval),
+		OpenTime:   candle.OpenTime.UTC(),
+		Open:       candle.Open,
+		High:       candle.High,
+		Low:        candle.Low,
+		Close:      candle.Close,
+		Ticks:      candle.Ticks,
+	}
+}
+
+// newHistoryPointResponse converts history point to its JSON representation
+func newHistoryPointResponse(point *entities.HistoryPoint) HistoryPointResponse {
+	return HistoryPointResponse{
+		Time:     point.Time.UTC(),
+		Price:    point.Price,
+		MinPrice: point.MinPrice,
+		MaxPrice: point.MaxPrice,
+		Ticks:    point.Ticks,
+	}
+}
+
+// newTickResponse converts price observation to its JSON representation
+func newTickResponse(tick *entities.PriceTick) TickResponse {
+	return TickResponse{
+		Price:      tick.Price,
+		ObservedAt: tick.ObservedAt.UTC(),
+		Source:     tick.Source,
+	}
+}
+
+// newProviderStatusResponse converts provider status to its JSON representation
+func newProviderStatusResponse(status *entities.ProviderStatus) ProviderStatusResponse {
+	response := ProviderStatusResponse{
+		Name:      status.Name,
+		State:     status.State,
+		Failures:  status.Failures,
+		LastError: status.LastError,
+	}
+	if !status.RetryAt.IsZero() {
+		retryAt := status.RetryAt.UTC()
+		response.RetryAt = &retryAt
+	}
+	return response
+}
+
+// newCoinResponse converts catalog coin to its JSON representation
+func newCoinResponse(coin *entities.Coin) CoinResponse {
+	return CoinResponse{
+		ID:      coin.ID,
+		Symbol:  coin.Symbol,
+		Name:    coin.Name,
+		Enabled: coin.Enabled,
+		AddedAt: coin.AddedAt.UTC(),
+	}
+}
+
+// newPortfolioResponse converts portfolio to its JSON representation
+func newPortfolioResponse(portfolio *entities.Portfolio) PortfolioResponse {
+	response := PortfolioResponse{
+		Positions: make([]PositionResponse, 0, len(portfolio.Positions)),
+		Totals:    make([]PortfolioTotalResponse, 0, len(portfolio.Totals)),
+	}
+	for _, position := range portfolio.Positions {
+		item := PositionResponse{
+			CurrencyID:   position.CurrencyID,
+			Quote:        position.Quote,
+			Amount:       position.Amount,
+			CostBasis:    position.CostBasis,
+			AveragePrice: position.AveragePrice,
+			RealizedPnL:  position.RealizedPnL,
+		}
+		if position.Priced {
+			currentPrice, value, unrealized := position.CurrentPrice, position.Value, position.UnrealizedPnL
+			item.CurrentPrice, item.Value, item.UnrealizedPnL = &currentPrice, &value, &unrealized
+		}
+		response.Positions = append(response.Positions, item)
+	}
+	for _, total := range portfolio.Totals {
+		response.Totals = append(response.Totals, PortfolioTotalResponse{
+			Quote:         total.Quote,
+			Value:         total.Value,
+			CostBasis:     total.CostBasis,
+			RealizedPnL:   total.RealizedPnL,
+			UnrealizedPnL: total.UnrealizedPnL,
+		})
+	}
+	return response
+}
+
+// negotiate selects response media type from Accept header
+// Plain text stays default for missing header and wildcards
+func negotiate(r *http.Request) string {
+	best, bestQ := mediaText, 0.0
+	for _, part := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		q := 1.0
+		if value, ok := params["q"]; ok {
+			if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+				q = parsed
+			}
+		}
+
+		switch mediaType {
+		case mediaJSON, mediaText:
+		case "application/*":
+			mediaType = mediaJSON
+		case "text/*":
+			mediaType = mediaText
+		default:
+			continue
+		}
+		if q > bestQ || (q == bestQ && mediaType == mediaText) {
+			best, bestQ = mediaType, q
+		}
+	}
+	return best
+}
+
+// wantsJSON reports whether client negotiated JSON response
+func wantsJSON(r *http.Request) bool {
+	return negotiate(r) == mediaJSON
+}
+
+// writeJSON writes value as JSON response with given status
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(value)
+}
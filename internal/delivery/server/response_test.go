@@ -0,0 +1,123 @@
+package server
+
+import (
+	"context"
+	"currencyhub/internal/entities"
+	"currencyhub/internal/interfaces"
+	"currencyhub/internal/usecases"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testQuotes are quote currencies fetched in tests
+var testQuotes, _ = entities.NewQuoteSet([]string{"usd", "eur", "rub", "btc"})
+
+type stubCurrencyRepository struct {
+	interfaces.CurrencyRepository
+	rates []*entities.CurrencyRate
+}
+
+func (s *stubCurrencyRepository) GetRates(ctx context.Context, quote string) ([]*entities.CurrencyRate, error) {
+	return s.rates, nil
+}
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   string
+	}{
+		{"", mediaText},
+		{"*/*", mediaText},
+		{"text/plain", mediaText},
+		{"application/json", mediaJSON},
+		{"application/json; charset=utf-8", mediaJSON},
+		{"text/html, application/json;q=0.9, */*;q=0.8", mediaJSON},
+		{"application/json;q=0.5, text/plain", mediaText},
+		{"application/*", mediaJSON},
+		{"image/png", mediaText},
+	}
+
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/rates", nil)
+		if tt.accept != "" {
+			r.Header.Set("Accept", tt.accept)
+		}
+		assert.Equal(t, tt.want, negotiate(r), "Accept: %q", tt.accept)
+	}
+}
+
+func TestGetRates_ContentNegotiation(t *testing.T) {
+	at := time.Date(2025, 1, 14, 12, 5, 0, 0, time.UTC)
+	repo := &stubCurrencyRepository{rates: []*entities.CurrencyRate{{
+		CurrencyID:   "bitcoin",
+		Quote:        "usd",
+		CurrentPrice: 97012.5,
+		MinPrice:     95400,
+		MaxPrice:     97830.1,
+		Change1h:     0.42,
+		Change24h:    -1.37,
+		Change7d:     4.8,
+		HourMinPrice: 96800,
+		HourMaxPrice: 97210,
+		TimeStamp:    at,
+		Date:         at.Truncate(24 * time.Hour),
+	}}}
+	handler := NewCurrencyHandler(usecase.NewCurrencyUseCase(repo), nil, nil, nil, NewHub(0, 0), testQuotes, slog.New(slog.NewTextHandler(io.Discard, nil)), "")
+
+	t.Run("json", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/rates", nil)
+		r.Header.Set("Accept", "application/json")
+		w := httptest.NewRecorder()
+
+		handler.GetRates(w, r)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Header().Get("Content-Type"), mediaJSON)
+
+		var response []RateResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		require.Len(t, response, 1)
+		assert.Equal(t, "bitcoin", response[0].CurrencyID)
+		assert.Equal(t, 96800.0, response[0].HourMinPrice)
+		assert.Equal(t, 97210.0, response[0].HourMaxPrice)
+		assert.Equal(t, 0.42, response[0].Change1h)
+		assert.Equal(t, 0.42, response[0].ChangePercent)
+		assert.Equal(t, -1.37, response[0].Change24h)
+		assert.Equal(t, 4.8, response[0].Change7d)
+		assert.True(t, at.Equal(response[0].Timestamp))
+		assert.Equal(t, "2025-01-14", response[0].Date)
+	})
+
+	t.Run("text by default", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/rates", nil)
+		w := httptest.NewRecorder()
+
+		handler.GetRates(w, r)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Header().Get("Content-Type"), mediaText)
+		assert.True(t, strings.HasPrefix(w.Body.String(), "CurrencyID: bitcoin\r\nCurrentPrice: 97012.50\r\n"))
+		assert.Contains(t, w.Body.String(), "ChangePercent: 0.42%\r\nChange1h: 0.42%\r\nChange24h: -1.37%\r\nChange7d: 4.80%\r\nQuote: usd")
+	})
+
+	t.Run("vary on both representations", func(t *testing.T) {
+		for _, accept := range []string{"", mediaJSON, mediaText} {
+			r := httptest.NewRequest(http.MethodGet, "/api/v1/rates", nil)
+			r.Header.Set("Accept", accept)
+			w := httptest.NewRecorder()
+
+			handler.Routes().ServeHTTP(w, r)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, []string{"Accept"}, w.Header().Values("Vary"), accept)
+		}
+	})
+}
@@ -0,0 +1,210 @@
+package server
+
+import (
+	"currencyhub/internal/entities"
+	"currencyhub/monitoring"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Server-Sent Events connection settings
+const (
+	sseSendBuffer   = 64               // Events queued per client before it is considered slow
+	sseWriteTimeout = 10 * time.Second // Time allowed to write single event
+	sseHeartbeat    = 15 * time.Second // Comment period keeping proxies from closing idle stream
+	sseRetry        = 5 * time.Second  // Reconnection delay suggested to clients
+)
+
+// sseClient is single Server-Sent Events connection with its fixed filter
+// Empty coin set means all coins
+type sseClient struct {
+	quote     string
+	coins     map[string]bool
+	events    chan *streamEvent
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// newSSEClient creates client receiving prices of coins in quote currency
+func newSSEClient(quote string, coinIDs []string) *sseClient {
+	coins := make(map[string]bool, len(coinIDs))
+	for _, id := range coinIDs {
+		coins[id] = true
+	}
+	return &sseClient{
+		quote:  quote,
+		coins:  coins,
+		events: make(chan *streamEvent, sseSendBuffer),
+		done:   make(chan struct{}),
+	}
+}
+
+// wants reports whether update matches client filter
+func (c *sseClient) wants(update *PriceUpdateResponse) bool {
+	return update.Quote == c.quote && (len(c.coins) == 0 || c.coins[update.CurrencyID])
+}
+
+// enqueue queues event for writing without blocking
+// Client that cannot keep up is disconnected and resumes via Last-Event-ID
+func (c *sseClient) enqueue(event *streamEvent) {
+	select {
+	case c.events <- event:
+	case <-c.done:
+	default:
+		monitoring.StreamDroppedClients.WithLabelValues("sse").Inc()
+		c.close()
+	}
+}
+
+// close stops client stream, only first call takes effect
+func (c *sseClient) close() {
+	c.closeOnce.Do(func() { close(c.done) })
+}
+
+// subscribeSSE registers client and returns buffered events it missed after lastEventID
+// Registration and replay happen atomically so no event is lost or repeated
+func (h *Hub) subscribeSSE(client *sseClient, lastEventID uint64, resume bool) ([]*streamEvent, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.connected() >= h.maxClients {
+		return nil, false
+	}
+	h.sseClients[client] = struct{}{}
+	monitoring.StreamClients.WithLabelValues("sse").Inc()
+
+	if !resume {
+		return nil, true
+	}
+	// Identifier from previous server run is ahead of sequence, whole buffer is replayed then
+	if lastEventID > h.sequence {
+		lastEventID = 0
+	}
+
+	var missed []*streamEvent
+	for _, event := range h.replay {
+		if event.ID > lastEventID && client.wants(&event.Update) {
+			missed = append(missed, event)
+		}
+	}
+	return missed, true
+}
+
+// unsubscribeSSE removes Server-Sent Events client from hub
+func (h *Hub) unsubscribeSSE(client *sseClient) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.sseClients[client]; ok {
+		delete(h.sseClients, client)
+		monitoring.StreamClients.WithLabelValues("sse").Dec()
+	}
+}
+
+// StreamEvents handles Server-Sent Events stream of saved prices
+// @Summary Поток цен через Server-Sent Events
+// @Description Отправляет каждую сохраненную цену выбранных монет событием price с порядковым id и данными PriceUpdateResponse.
+// @Description При переподключении клиент передает заголовок Last-Event-ID (или параметр last_event_id) и получает пропущенные события из буфера последних обновлений.
+// @Description Каждые 15 секунд отправляется комментарий-heartbeat, медленные клиенты отключаются
+// @Tags stream
+// @Produce text/event-stream
+// @Param coins query string false "Монеты через запятую: идентификатор, тикер или название. По умолчанию все"
+// @Param vs query string false "Валюта котировки из настройки fetcher.quotes, например usd, eur, rub, btc" default(usd)
+// @Param Last-Event-ID header string false "Идентификатор последнего полученного события"
+// @Param last_event_id query string false "Идентификатор последнего полученного события, если заголовок недоступен"
+// @Success 200 {object} PriceUpdateResponse "Поток событий price"
+// @Failure 400 {object} ErrorResponse "Неверные параметры запроса"
+// @Failure 404 {object} ErrorResponse "Монета не найдена"
+// @Failure 503 {object} ErrorResponse "Превышено число подключений"
+// @Router /api/v1/stream [get]
+func (h *CurrencyHandler) StreamEvents(w http.ResponseWriter, r *http.Request) {
+	quote, err := h.quoteParam(r)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	coinIDs, err := h.resolveCoins(r.Context(), splitList(r.URL.Query().Get("coins")))
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	lastEventID, resume, err := lastEventIDParam(r)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	client := newSSEClient(quote, coinIDs)
+	missed, ok := h.hub.subscribeSSE(client, lastEventID, resume)
+	if !ok {
+		writeErrorResponse(w, r, http.StatusServiceUnavailable, codeUnavailable, "too many stream connections", nil)
+		return
+	}
+	defer h.hub.unsubscribeSSE(client)
+
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	write := func(format string, args ...any) bool {
+		rc.SetWriteDeadline(time.Now().Add(sseWriteTimeout))
+		if _, err := fmt.Fprintf(w, format, args...); err != nil {
+			return false
+		}
+		return rc.Flush() == nil
+	}
+
+	if !write("retry: %d\n\n", sseRetry.Milliseconds()) {
+		return
+	}
+	for _, event := range missed {
+		if !write("id: %d\nevent: price\ndata: %s\n\n", event.ID, event.Data) {
+			return
+		}
+	}
+
+	heartbeat := time.NewTicker(sseHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-client.done:
+			return
+		case event := <-client.events:
+			if !write("id: %d\nevent: price\ndata: %s\n\n", event.ID, event.Data) {
+				return
+			}
+		case <-heartbeat.C:
+			if !write(": heartbeat\n\n") {
+				return
+			}
+		}
+	}
+}
+
+// lastEventIDParam reads identifier of last event client received
+// Header sent by reconnecting EventSource takes precedence over query parameter
+func lastEventIDParam(r *http.Request) (uint64, bool, error) {
+	value := r.Header.Get("Last-Event-ID")
+	if value == "" {
+		value = r.URL.Query().Get("last_event_id")
+	}
+	if value == "" {
+		return 0, false, nil
+	}
+	id, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, false, &entities.ValidationError{Field: "last_event_id", Message: fmt.Sprintf("invalid event id: %q", value)}
+	}
+	return id, true, nil
+}
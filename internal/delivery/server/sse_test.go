@@ -0,0 +1,79 @@
+package server
+
+import (
+	"bufio"
+	"currencyhub/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// readSSEEvent reads lines of next event skipping retry and heartbeat blocks
+func readSSEEvent(t *testing.T, reader *bufio.Reader) []string {
+	t.Helper()
+	for {
+		var lines []string
+		for {
+			line, err := reader.ReadString('\n')
+			require.NoError(t, err)
+			line = strings.TrimSuffix(line, "\n")
+			if line == "" {
+				break
+			}
+			lines = append(lines, line)
+		}
+		if len(lines) > 0 && strings.HasPrefix(lines[0], "id: ") {
+			return lines
+		}
+	}
+}
+
+func TestStreamEvents_ResumesFromLastEventID(t *testing.T) {
+	hub := NewHub(0, 0)
+	srv := newStreamServer(t, hub)
+
+	at := time.Date(2025, 1, 14, 12, 5, 0, 0, time.UTC)
+	hub.PublishPrice(&entities.PriceTick{CurrencyID: "bitcoin", Quote: "usd", Price: 1, ObservedAt: at})
+	hub.PublishPrice(&entities.PriceTick{CurrencyID: "ethereum", Quote: "usd", Price: 2, ObservedAt: at})
+	hub.PublishPrice(&entities.PriceTick{CurrencyID: "bitcoin", Quote: "usd", Price: 3, ObservedAt: at})
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/api/v1/stream?coins=btc", nil)
+	require.NoError(t, err)
+	req.Header.Set("Last-Event-ID", "1")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	reader := bufio.NewReader(resp.Body)
+	event := readSSEEvent(t, reader)
+	assert.Equal(t, "id: 3", event[0])
+	assert.Equal(t, "event: price", event[1])
+	assert.Contains(t, event[2], `"price":3`)
+
+	hub.PublishPrice(&entities.PriceTick{CurrencyID: "ethereum", Quote: "usd", Price: 4, ObservedAt: at})
+	hub.PublishPrice(&entities.PriceTick{CurrencyID: "bitcoin", Quote: "usd", Price: 5, ObservedAt: at})
+
+	event = readSSEEvent(t, reader)
+	assert.Equal(t, "id: 5", event[0])
+	assert.Contains(t, event[2], `"price":5`)
+}
+
+func TestHub_ReplayBufferBounded(t *testing.T) {
+	hub := NewHub(0, 2)
+	for i := 0; i < 5; i++ {
+		hub.PublishPrice(&entities.PriceTick{CurrencyID: "bitcoin", Quote: "usd", Price: float64(i)})
+	}
+
+	missed, ok := hub.subscribeSSE(newSSEClient("usd", nil), 0, true)
+
+	require.True(t, ok)
+	require.Len(t, missed, 2)
+	assert.Equal(t, uint64(4), missed[0].ID)
+	assert.Equal(t, uint64(5), missed[1].ID)
+}
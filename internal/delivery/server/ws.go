@@ -0,0 +1,440 @@
+package server
+
+import (
+	"context"
+	"currencyhub/internal/entities"
+	"currencyhub/monitoring"
+	"encoding/json"
+	"errors"
+	"github.com/gorilla/websocket"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultMaxStreamClients is used when stream connection cap is not configured
+// DefaultStreamReplay is used when replay buffer size is not configured
+const (
+	DefaultMaxStreamClients = 1000
+	DefaultStreamReplay     = 1000
+)
+
+// WebSocket connection settings
+const (
+	wsSendBuffer     = 64               // Messages queued per client before it is considered slow
+	wsWriteTimeout   = 10 * time.Second // Time allowed to write single message
+	wsPongTimeout    = 60 * time.Second // Time allowed to receive pong after last one
+	wsPingInterval   = 30 * time.Second // Heartbeat period, must be shorter than pong timeout
+	wsMaxMessageSize = 4096             // Largest accepted client message
+)
+
+// WebSocket message types
+const (
+	wsTypePrice        = "price"
+	wsTypeSubscribed   = "subscribed"
+	wsTypeUnsubscribed = "unsubscribed"
+	wsTypeError        = "error"
+)
+
+// codeUnavailable is error code of requests rejected by connection cap
+const codeUnavailable = "unavailable"
+
+// wsUpgrader upgrades HTTP connections to WebSocket
+// Any origin is allowed since price stream is public and read-only
+var wsUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	CheckOrigin:     func(r *http.Request) bool { return true },
+}
+
+// streamEvent is saved price numbered in publication order
+type streamEvent struct {
+	ID     uint64
+	Update PriceUpdateResponse
+	Data   []byte // JSON encoded update
+}
+
+// Hub fans out saved prices to subscribed WebSocket and SSE clients
+// Slow clients are disconnected instead of holding back price updates
+type Hub struct {
+	maxClients int
+	replaySize int
+	mu         sync.RWMutex
+	sequence   uint64
+	replay     []*streamEvent
+	clients    map[*wsClient]struct{}
+	sseClients map[*sseClient]struct{}
+}
+
+// NewHub creates stream hub instance
+// Initializes with cap on simultaneous connections and number of events kept for resume
+func NewHub(maxClients, replaySize int) *Hub {
+	if maxClients <= 0 {
+		maxClients = DefaultMaxStreamClients
+	}
+	if replaySize <= 0 {
+		replaySize = DefaultStreamReplay
+	}
+	return &Hub{
+		maxClients: maxClients,
+		replaySize: replaySize,
+		clients:    make(map[*wsClient]struct{}),
+		sseClients: make(map[*sseClient]struct{}),
+	}
+}
+
+// Run forwards price updates from event bus to stream clients
+// Returns when context is cancelled or updates channel is closed
+func (h *Hub) Run(ctx context.Context, updates <-chan entities.PriceUpdated) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case update, ok := <-updates:
+			if !ok {
+				return
+			}
+			h.PublishPrice(update.Tick)
+		}
+	}
+}
+
+// PublishPrice delivers saved price to clients subscribed to its coin and quote
+// Never blocks, clients with full send buffer are disconnected
+func (h *Hub) PublishPrice(tick *entities.PriceTick) {
+	update := newPriceUpdateResponse(tick)
+	data, err := json.Marshal(update)
+	if err != nil {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.sequence++
+	event := &streamEvent{ID: h.sequence, Update: update, Data: data}
+	if len(h.replay) == h.replaySize {
+		h.replay = append(h.replay[:0], h.replay[1:]...)
+	}
+	h.replay = append(h.replay, event)
+
+	var message []byte
+	for client := range h.clients {
+		if !client.subscribed(tick.CurrencyID, tick.Quote) {
+			continue
+		}
+		if message == nil {
+			message, _ = json.Marshal(wsMessage{Type: wsTypePrice, Price: &update})
+		}
+		client.enqueue(message)
+	}
+	for client := range h.sseClients {
+		if client.wants(&update) {
+			client.enqueue(event)
+		}
+	}
+}
+
+// Close disconnects all clients
+// Used on server shutdown since streaming connections outlive http.Server
+func (h *Hub) Close() {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for client := range h.clients {
+		client.close(websocket.CloseGoingAway, "server shutdown")
+	}
+	for client := range h.sseClients {
+		client.close()
+	}
+}
+
+// full reports whether connection cap is reached
+func (h *Hub) full() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.connected() >= h.maxClients
+}
+
+// connected returns number of clients of all transports, caller holds lock
+func (h *Hub) connected() int {
+	return len(h.clients) + len(h.sseClients)
+}
+
+// register adds WebSocket client to hub
+// Returns false when connection cap is reached
+func (h *Hub) register(client *wsClient) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.connected() >= h.maxClients {
+		return false
+	}
+	h.clients[client] = struct{}{}
+	monitoring.StreamClients.WithLabelValues("websocket").Inc()
+	return true
+}
+
+// unregister removes WebSocket client from hub
+func (h *Hub) unregister(client *wsClient) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.clients[client]; ok {
+		delete(h.clients, client)
+		monitoring.StreamClients.WithLabelValues("websocket").Dec()
+	}
+}
+
+// wsMessage is message exchanged over price WebSocket
+// Clients send action with coins, server replies with type and payload
+type wsMessage struct {
+	Type   string               `json:"type,omitempty"`
+	Action string               `json:"action,omitempty"`
+	Coins  []string             `json:"coins,omitempty"`
+	Price  *PriceUpdateResponse `json:"price,omitempty"`
+	Error  *ErrorResponse       `json:"error,omitempty"`
+}
+
+// wsClient is single WebSocket connection with its subscriptions
+type wsClient struct {
+	conn      *websocket.Conn
+	quote     string
+	send      chan []byte
+	done      chan struct{}
+	closeOnce sync.Once
+	closeCode int
+	closeText string
+	mu        sync.RWMutex
+	coins     map[string]bool
+}
+
+// newWSClient creates client receiving prices in quote currency
+func newWSClient(conn *websocket.Conn, quote string) *wsClient {
+	return &wsClient{
+		conn:  conn,
+		quote: quote,
+		send:  make(chan []byte, wsSendBuffer),
+		done:  make(chan struct{}),
+		coins: make(map[string]bool),
+	}
+}
+
+// subscribed reports whether client wants prices of coin in quote
+func (c *wsClient) subscribed(coinID, quote string) bool {
+	if quote != c.quote {
+		return false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.coins[coinID]
+}
+
+// subscribe adds coins to client subscriptions
+func (c *wsClient) subscribe(coinIDs []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, id := range coinIDs {
+		c.coins[id] = true
+	}
+}
+
+// unsubscribe removes coins from client subscriptions
+func (c *wsClient) unsubscribe(coinIDs []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, id := range coinIDs {
+		delete(c.coins, id)
+	}
+}
+
+// enqueue queues message for writing without blocking
+// Client that cannot keep up is disconnected
+func (c *wsClient) enqueue(message []byte) {
+	select {
+	case c.send <- message:
+	case <-c.done:
+	default:
+		monitoring.StreamDroppedClients.WithLabelValues("websocket").Inc()
+		c.close(websocket.CloseTryAgainLater, "client too slow")
+	}
+}
+
+// enqueueJSON encodes and queues message for writing
+func (c *wsClient) enqueueJSON(message wsMessage) {
+	encoded, err := json.Marshal(message)
+	if err != nil {
+		return
+	}
+	c.enqueue(encoded)
+}
+
+// close stops client writer with given close status
+// Only first call takes effect
+func (c *wsClient) close(code int, text string) {
+	c.closeOnce.Do(func() {
+		c.closeCode, c.closeText = code, text
+		close(c.done)
+	})
+}
+
+// writePump writes queued messages and heartbeats to connection
+// Exits when client is closed or write fails
+func (c *wsClient) writePump() {
+	ticker := time.NewTicker(wsPingInterval)
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+	}()
+
+	for {
+		select {
+		case message := <-c.send:
+			c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+			if err := c.conn.WriteMessage(websocket.TextMessage, message); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteTimeout)); err != nil {
+				return
+			}
+		case <-c.done:
+			message := websocket.FormatCloseMessage(c.closeCode, c.closeText)
+			c.conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(wsWriteTimeout))
+			return
+		}
+	}
+}
+
+// StreamRates handles WebSocket connection streaming saved prices
+// @Summary Поток цен через WebSocket
+// @Description Открывает WebSocket, в который отправляется каждая сохраненная цена монет из подписки: {"type": "price", "price": {...}}.
+// @Description Подписка меняется сообщениями {"action": "subscribe", "coins": ["btc"]} и {"action": "unsubscribe", "coins": ["btc"]}.
+// @Description Сервер отправляет ping каждые 30 секунд, медленные клиенты отключаются с кодом 1013
+// @Tags stream
+// @Param coins query string false "Монеты через запятую: идентификатор, тикер или название"
+// @Param vs query string false "Валюта котировки из настройки fetcher.quotes, например usd, eur, rub, btc" default(usd)
+// @Success 101 {object} PriceUpdateResponse "Переключение на WebSocket"
+// @Failure 400 {object} ErrorResponse "Неверные параметры запроса"
+// @Failure 404 {object} ErrorResponse "Монета не найдена"
+// @Failure 503 {object} ErrorResponse "Превышено число подключений"
+// @Router /ws/rates [get]
+func (h *CurrencyHandler) StreamRates(w http.ResponseWriter, r *http.Request) {
+	quote, err := h.quoteParam(r)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	coinIDs, err := h.resolveCoins(r.Context(), splitList(r.URL.Query().Get("coins")))
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	if h.hub.full() {
+		writeErrorResponse(w, r, http.StatusServiceUnavailable, codeUnavailable, "too many stream connections", nil)
+		return
+	}
+
+	conn, err := wsUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+
+	client := newWSClient(conn, quote)
+	if !h.hub.register(client) {
+		message := websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "too many stream connections")
+		conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(wsWriteTimeout))
+		conn.Close()
+		return
+	}
+	defer h.hub.unregister(client)
+
+	client.subscribe(coinIDs)
+	go client.writePump()
+	if len(coinIDs) > 0 {
+		client.enqueueJSON(wsMessage{Type: wsTypeSubscribed, Coins: coinIDs})
+	}
+
+	h.readClientMessages(r.Context(), client)
+}
+
+// readClientMessages processes subscription changes until connection closes
+// Keeps read deadline moving forward on every pong
+func (h *CurrencyHandler) readClientMessages(ctx context.Context, client *wsClient) {
+	defer client.close(websocket.CloseNormalClosure, "")
+
+	conn := client.conn
+	conn.SetReadLimit(wsMaxMessageSize)
+	conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+	})
+
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var message wsMessage
+		if err := json.Unmarshal(data, &message); err != nil {
+			client.enqueueJSON(wsError(codeInvalidArgument, "message must be JSON object"))
+			continue
+		}
+
+		coinIDs, err := h.resolveCoins(ctx, message.Coins)
+		if errors.Is(err, entities.ErrCoinNotFound) {
+			client.enqueueJSON(wsError(codeCoinNotFound, err.Error()))
+			continue
+		}
+		if err != nil {
+			h.logger.Error("Failed to resolve stream coins", "error", err)
+			client.enqueueJSON(wsError(codeInternal, internalErrorMessage))
+			continue
+		}
+
+		switch message.Action {
+		case "subscribe":
+			client.subscribe(coinIDs)
+			client.enqueueJSON(wsMessage{Type: wsTypeSubscribed, Coins: coinIDs})
+		case "unsubscribe":
+			client.unsubscribe(coinIDs)
+			client.enqueueJSON(wsMessage{Type: wsTypeUnsubscribed, Coins: coinIDs})
+		default:
+			client.enqueueJSON(wsError(codeInvalidArgument, "unknown action: "+message.Action))
+		}
+	}
+}
+
+// resolveCoins resolves coin queries to tracked coin identifiers
+// Returns first resolution error, e.g. CoinNotFoundError with suggestions
+func (h *CurrencyHandler) resolveCoins(ctx context.Context, queries []string) ([]string, error) {
+	coinIDs := make([]string, 0, len(queries))
+	for _, query := range queries {
+		coin, err := h.coinUseCase.Resolve(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		coinIDs = append(coinIDs, coin.ID)
+	}
+	return coinIDs, nil
+}
+
+// wsError builds error message sent over WebSocket
+func wsError(code, message string) wsMessage {
+	return wsMessage{Type: wsTypeError, Error: &ErrorResponse{Code: code, Message: message}}
+}
+
+// splitList splits comma separated query value skipping empty items
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,117 @@
+package server
+
+import (
+	"context"
+	"currencyhub/internal/entities"
+	"currencyhub/internal/interfaces"
+	"currencyhub/internal/usecases"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// stubCoinRepository serves fixed coin catalog without aliases
+type stubCoinRepository struct {
+	interfaces.CoinRepository
+	coins []*entities.Coin
+}
+
+func (s *stubCoinRepository) GetCoins(ctx context.Context, enabledOnly bool) ([]*entities.Coin, error) {
+	return s.coins, nil
+}
+
+func (s *stubCoinRepository) GetAliases(ctx context.Context) (map[string]string, error) {
+	return map[string]string{}, nil
+}
+
+func newStreamServer(t *testing.T, hub *Hub) *httptest.Server {
+	t.Helper()
+	coins := usecase.NewCoinUseCase(&stubCoinRepository{coins: []*entities.Coin{
+		{ID: "bitcoin", Symbol: "BTC", Name: "Bitcoin", Enabled: true},
+		{ID: "ethereum", Symbol: "ETH", Name: "Ethereum", Enabled: true},
+	}}, nil)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	handler := NewCurrencyHandler(usecase.NewCurrencyUseCase(&stubCurrencyRepository{}), coins, nil, nil, hub, testQuotes, logger, "")
+
+	srv := httptest.NewServer(handler.Routes())
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func dialStream(t *testing.T, srv *httptest.Server, query string) *websocket.Conn {
+	t.Helper()
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/ws/rates?" + query
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func readStream(t *testing.T, conn *websocket.Conn) wsMessage {
+	t.Helper()
+	var message wsMessage
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	require.NoError(t, conn.ReadJSON(&message))
+	return message
+}
+
+func TestStreamRates_Subscriptions(t *testing.T) {
+	hub := NewHub(0, 0)
+	conn := dialStream(t, newStreamServer(t, hub), "coins=btc")
+
+	assert.Equal(t, wsMessage{Type: wsTypeSubscribed, Coins: []string{"bitcoin"}}, readStream(t, conn))
+
+	require.NoError(t, conn.WriteJSON(wsMessage{Action: "subscribe", Coins: []string{"Ethereum"}}))
+	assert.Equal(t, wsMessage{Type: wsTypeSubscribed, Coins: []string{"ethereum"}}, readStream(t, conn))
+
+	at := time.Date(2025, 1, 14, 12, 5, 0, 0, time.UTC)
+	hub.PublishPrice(&entities.PriceTick{CurrencyID: "solana", Quote: "usd", Price: 180, ObservedAt: at})
+	hub.PublishPrice(&entities.PriceTick{CurrencyID: "ethereum", Quote: "eur", Price: 3100, ObservedAt: at})
+	hub.PublishPrice(&entities.PriceTick{CurrencyID: "ethereum", Quote: "usd", Price: 3300, ObservedAt: at, Source: "median"})
+
+	message := readStream(t, conn)
+	require.Equal(t, wsTypePrice, message.Type)
+	assert.Equal(t, "ethereum", message.Price.CurrencyID)
+	assert.Equal(t, 3300.0, message.Price.Price)
+
+	require.NoError(t, conn.WriteJSON(wsMessage{Action: "subscribe", Coins: []string{"bitcoinn"}}))
+	message = readStream(t, conn)
+	require.Equal(t, wsTypeError, message.Type)
+	assert.Equal(t, codeCoinNotFound, message.Error.Code)
+}
+
+func TestStreamRates_ConnectionCap(t *testing.T) {
+	hub := NewHub(1, 0)
+	srv := newStreamServer(t, hub)
+	dialStream(t, srv, "")
+
+	require.Eventually(t, hub.full, time.Second, 10*time.Millisecond)
+
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/ws/rates"
+	_, resp, err := websocket.DefaultDialer.Dial(url, nil)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+}
+
+func TestWSClient_SlowClientDisconnected(t *testing.T) {
+	client := newWSClient(nil, "usd")
+	for i := 0; i < wsSendBuffer; i++ {
+		client.enqueue([]byte("{}"))
+	}
+
+	client.enqueue([]byte("{}"))
+
+	select {
+	case <-client.done:
+		assert.Equal(t, websocket.CloseTryAgainLater, client.closeCode)
+	default:
+		t.Fatal("slow client was not closed")
+	}
+}
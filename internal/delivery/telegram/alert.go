@@ -0,0 +1,257 @@
+package telegram
+
+import (
+	"context"
+	"currencyhub/internal/entities"
+	"currencyhub/internal/infrastructure/i18n"
+	"currencyhub/internal/usecases"
+	"errors"
+	"fmt"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// alertPattern matches threshold /alert arguments: coin, comparison sign and threshold
+// Threshold may contain separators of user language, it is parsed by localizer
+var alertPattern = regexp.MustCompile(`^(.+?)\s*([<>])\s*([0-9][0-9.,\x{00a0}]*)$`)
+
+// movePattern matches move /alert arguments: coin, optionally signed percent, window and cooldown
+var movePattern = regexp.MustCompile(`^(.+?)\s+([+-]?)([0-9][0-9.,]*)\s*%(?:\s+(\S+))?(?:\s+(\S+))?$`)
+
+// defaultAlertWindow is window of move alert created without one
+const defaultAlertWindow = time.Hour
+
+// handleAlert processes /alert command - creates price threshold or percent move alert
+func (b *Bot) handleAlert(ctx context.Context, message *tgbotapi.Message) {
+	loc := b.localizer(ctx, message.Chat.ID, message.From)
+	query, alert, ok := parseAlert(loc, strings.TrimSpace(message.CommandArguments()))
+	if !ok {
+		b.sendMessage(message.Chat.ID, loc.T("alert.usage"))
+		return
+	}
+
+	coin, ok := b.resolveCoin(ctx, loc, message.Chat.ID, query)
+	if !ok {
+		return
+	}
+	alert.UserID = message.Chat.ID
+	alert.CurrencyID = coin.ID
+	alert.Quote = b.userQuote(ctx, message.Chat.ID)
+
+	err := b.alertUseCase.CreateAlert(ctx, alert)
+	var validationErr *entities.ValidationError
+	switch {
+	case err == nil:
+		msg := loc.T("alert.created", alert.ID, formatAlert(loc, alert))
+		if alert.Condition.IsMove() {
+			msg += loc.T("alert.cooldown", formatWindow(alert.Cooldown()))
+		}
+		b.sendMessage(message.Chat.ID, msg)
+	case errors.Is(err, entities.ErrAlertConditionMet):
+		b.sendMessage(message.Chat.ID, loc.T("alert.condition_met", alert.CurrencyID, formatAlertCondition(loc, alert)))
+	case errors.Is(err, entities.ErrTooManyAlerts):
+		b.sendMessage(message.Chat.ID, loc.N("alert.too_many", usecase.MaxUserAlerts, usecase.MaxUserAlerts))
+	case errors.As(err, &validationErr) && (validationErr.Field == "window" || validationErr.Field == "cooldown"):
+		b.sendMessage(message.Chat.ID, loc.T("alert.bad_window",
+			formatWindow(usecase.MinAlertWindow), formatWindow(usecase.MaxAlertWindow)))
+	case errors.Is(err, entities.ErrInvalidArgument):
+		b.sendMessage(message.Chat.ID, loc.T("alert.bad_threshold"))
+	default:
+		b.logger.Error("Failed to create alert", "currency", coin.ID, "error", err)
+		b.sendMessage(message.Chat.ID, loc.T("alert.create_error"))
+	}
+}
+
+// parseAlert reads coin query and alert rule from /alert arguments
+// Numbers are read with separators of user language, ambiguous ones are rejected
+func parseAlert(loc i18n.Localizer, args string) (string, *entities.Alert, bool) {
+	if match := alertPattern.FindStringSubmatch(args); match != nil {
+		threshold, err := loc.ParseNumber(match[3])
+		if err != nil {
+			return "", nil, false
+		}
+		condition := entities.AlertAbove
+		if match[2] == "<" {
+			condition = entities.AlertBelow
+		}
+		return match[1], &entities.Alert{Condition: condition, Threshold: threshold}, true
+	}
+
+	match := movePattern.FindStringSubmatch(args)
+	if match == nil {
+		return "", nil, false
+	}
+	percent, err := loc.ParseNumber(match[3])
+	if err != nil {
+		return "", nil, false
+	}
+	condition := entities.AlertMove
+	switch match[2] {
+	case "+":
+		condition = entities.AlertRise
+	case "-":
+		condition = entities.AlertFall
+	}
+
+	window := defaultAlertWindow
+	if match[4] != "" {
+		if window, err = parseWindow(match[4]); err != nil {
+			return "", nil, false
+		}
+	}
+	var cooldown time.Duration
+	if match[5] != "" {
+		if cooldown, err = parseWindow(match[5]); err != nil {
+			return "", nil, false
+		}
+	}
+
+	return match[1], &entities.Alert{
+		Condition:       condition,
+		Threshold:       percent,
+		WindowSeconds:   int64(window / time.Second),
+		CooldownSeconds: int64(cooldown / time.Second),
+	}, true
+}
+
+// parseWindow parses period like 30m, 1h or 1d
+func parseWindow(value string) (time.Duration, error) {
+	value = strings.ToLower(value)
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(value)
+}
+
+// formatWindow formats period the way parseWindow reads it
+func formatWindow(d time.Duration) string {
+	if d >= 24*time.Hour && d%(24*time.Hour) == 0 {
+		return fmt.Sprintf("%dd", d/(24*time.Hour))
+	}
+
+	var formatted strings.Builder
+	if hours := d / time.Hour; hours > 0 {
+		fmt.Fprintf(&formatted, "%dh", hours)
+	}
+	if minutes := d % time.Hour / time.Minute; minutes > 0 {
+		fmt.Fprintf(&formatted, "%dm", minutes)
+	}
+	if seconds := d % time.Minute / time.Second; seconds > 0 || formatted.Len() == 0 {
+		fmt.Fprintf(&formatted, "%ds", seconds)
+	}
+	return formatted.String()
+}
+
+// handleAlerts processes /alerts command - lists active alerts of user
+func (b *Bot) handleAlerts(ctx context.Context, message *tgbotapi.Message) {
+	loc := b.localizer(ctx, message.Chat.ID, message.From)
+	alerts, err := b.alertUseCase.GetUserAlerts(ctx, message.Chat.ID)
+	if err != nil {
+		b.logger.Error("Failed to get alerts", "error", err)
+		b.sendMessage(message.Chat.ID, loc.T("alerts.error"))
+		return
+	}
+	if len(alerts) == 0 {
+		b.sendMessage(message.Chat.ID, loc.T("alerts.empty"))
+		return
+	}
+
+	var msg strings.Builder
+	msg.WriteString(loc.N("alerts.title", int64(len(alerts)), len(alerts)))
+	for _, alert := range alerts {
+		msg.WriteString(fmt.Sprintf("#%d %s\n", alert.ID, formatAlert(loc, alert)))
+	}
+	msg.WriteString(loc.T("alerts.delete_hint"))
+	b.sendMessage(message.Chat.ID, msg.String())
+}
+
+// handleAlertDelete processes /alert_del command - removes alert by identifier
+func (b *Bot) handleAlertDelete(ctx context.Context, message *tgbotapi.Message) {
+	loc := b.localizer(ctx, message.Chat.ID, message.From)
+	arg := strings.TrimPrefix(strings.TrimSpace(message.CommandArguments()), "#")
+	alertID, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		b.sendMessage(message.Chat.ID, loc.T("alert.delete_usage"))
+		return
+	}
+
+	err = b.alertUseCase.DeleteAlert(ctx, message.Chat.ID, alertID)
+	if errors.Is(err, entities.ErrAlertNotFound) {
+		b.sendMessage(message.Chat.ID, loc.T("alert.not_found"))
+		return
+	}
+	if err != nil {
+		b.logger.Error("Failed to delete alert", "alert", alertID, "error", err)
+		b.sendMessage(message.Chat.ID, loc.T("alert.delete_error"))
+		return
+	}
+
+	b.sendMessage(message.Chat.ID, loc.T("alert.deleted", alertID))
+}
+
+// sendAlerts notifies users about their fired alerts in their languages
+func (b *Bot) sendAlerts(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-b.alerts:
+			if !ok {
+				return
+			}
+			loc := b.localizer(ctx, event.Alert.UserID, nil)
+			b.sendMessage(event.Alert.UserID, formatAlertTriggered(loc, event))
+		}
+	}
+}
+
+// formatAlertTriggered builds notification about fired alert
+func formatAlertTriggered(loc i18n.Localizer, event entities.AlertTriggered) string {
+	alert := event.Alert
+	price := loc.Price(event.Tick.Price, event.Tick.Quote)
+	if !alert.Condition.IsMove() {
+		return loc.T("alert.triggered", alert.ID, alert.CurrencyID, formatAlertCondition(loc, alert), price)
+	}
+
+	key := "alert.rose"
+	if event.Change < 0 {
+		key = "alert.fell"
+	}
+	return loc.T(key, alert.ID, alert.CurrencyID, loc.Number(math.Abs(event.Change), 2), formatWindow(alert.Window()), price,
+		event.Base.ObservedAt.UTC().Format("02.01 15:04 UTC"), loc.Price(event.Base.Price, event.Base.Quote))
+}
+
+// formatAlert formats alert rule as coin, comparison sign and threshold
+// Move alert is shown as coin, signed percent and window
+func formatAlert(loc i18n.Localizer, alert *entities.Alert) string {
+	switch alert.Condition {
+	case entities.AlertRise:
+		return loc.T("alert.move", alert.CurrencyID, "+", loc.Amount(alert.Threshold), formatWindow(alert.Window()))
+	case entities.AlertFall:
+		return loc.T("alert.move", alert.CurrencyID, "-", loc.Amount(alert.Threshold), formatWindow(alert.Window()))
+	case entities.AlertMove:
+		return loc.T("alert.move", alert.CurrencyID, "±", loc.Amount(alert.Threshold), formatWindow(alert.Window()))
+	}
+
+	sign := ">"
+	if alert.Condition == entities.AlertBelow {
+		sign = "<"
+	}
+	return fmt.Sprintf("%s %s %s", alert.CurrencyID, sign, loc.Price(alert.Threshold, alert.Quote))
+}
+
+// formatAlertCondition describes alert condition in words
+func formatAlertCondition(loc i18n.Localizer, alert *entities.Alert) string {
+	if alert.Condition == entities.AlertBelow {
+		return loc.T("alert.below", loc.Price(alert.Threshold, alert.Quote))
+	}
+	return loc.T("alert.above", loc.Price(alert.Threshold, alert.Quote))
+}
@@ -0,0 +1,74 @@
+package telegram
+
+import (
+	"currencyhub/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestFormatWindow(t *testing.T) {
+	tests := []struct {
+		window time.Duration
+		want   string
+	}{
+		{time.Minute, "1m"},
+		{10 * time.Minute, "10m"},
+		{30 * time.Minute, "30m"},
+		{time.Hour, "1h"},
+		{90 * time.Minute, "1h30m"},
+		{10 * time.Hour, "10h"},
+		{24 * time.Hour, "1d"},
+		{36 * time.Hour, "36h"},
+		{7 * 24 * time.Hour, "7d"},
+		{90 * time.Second, "1m30s"},
+		{0, "0s"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, formatWindow(tt.window))
+
+		parsed, err := parseWindow(tt.want)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.window, parsed)
+	}
+}
+
+func TestParseAlert_Threshold(t *testing.T) {
+	en, ru := translator.Localizer("en"), translator.Localizer("ru")
+
+	query, alert, ok := parseAlert(en, "ethereum < 2,500")
+	require.True(t, ok)
+	assert.Equal(t, "ethereum", query)
+	assert.Equal(t, entities.AlertBelow, alert.Condition)
+	assert.Equal(t, 2500.0, alert.Threshold)
+
+	_, alert, ok = parseAlert(en, "bitcoin > 62,000.50")
+	require.True(t, ok)
+	assert.Equal(t, 62000.5, alert.Threshold)
+
+	_, alert, ok = parseAlert(ru, "bitcoin > 2,5")
+	require.True(t, ok)
+	assert.Equal(t, 2.5, alert.Threshold)
+
+	_, _, ok = parseAlert(en, "bitcoin > 2,5")
+	assert.False(t, ok, "comma is not decimal separator in English")
+}
+
+func TestParseAlert_Move(t *testing.T) {
+	en, ru := translator.Localizer("en"), translator.Localizer("ru")
+
+	_, alert, ok := parseAlert(ru, "solana -2,5% 4h")
+	require.True(t, ok)
+	assert.Equal(t, entities.AlertFall, alert.Condition)
+	assert.Equal(t, 2.5, alert.Threshold)
+	assert.Equal(t, int64(4*3600), alert.WindowSeconds)
+
+	_, alert, ok = parseAlert(en, "solana 2.5%")
+	require.True(t, ok)
+	assert.Equal(t, 2.5, alert.Threshold)
+
+	_, _, ok = parseAlert(en, "solana 2,5%")
+	assert.False(t, ok, "comma is not decimal separator in English")
+}
@@ -4,6 +4,7 @@ package telegram
 
 import (
 	"context"
+	"currencyhub/internal/entities"
 	"currencyhub/internal/usecases"
 	"fmt"
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
@@ -14,16 +15,21 @@ import (
 // Bot manages Telegram bot lifecycle and message processing
 // Handles incoming updates and command routing
 type Bot struct {
-	logger          *slog.Logger
-	userUseCase     *usecase.UserUseCase
-	currencyUseCase *usecase.CurrencyUseCase
-	lastSentMap     map[int64]time.Time
-	Api             *tgbotapi.BotAPI
+	logger           *slog.Logger
+	userUseCase      *usecase.UserUseCase
+	currencyUseCase  *usecase.CurrencyUseCase
+	coinUseCase      *usecase.CoinUseCase
+	alertUseCase     *usecase.AlertUseCase
+	portfolioUseCase *usecase.PortfolioUseCase
+	prices           <-chan entities.PriceUpdated
+	alerts           <-chan entities.AlertTriggered
+	lastSentMap      map[int64]time.Time
+	Api              *tgbotapi.BotAPI
 }
 
 // NewBot creates new Telegram bot instance
-// Initializes with message handler and logger
-func NewBot(userUseCase *usecase.UserUseCase, currencyUseCase *usecase.CurrencyUseCase, logger *slog.Logger, token string) (*Bot, error) {
+// Initializes with use cases, price update and fired alert subscriptions and logger
+func NewBot(userUseCase *usecase.UserUseCase, currencyUseCase *usecase.CurrencyUseCase, coinUseCase *usecase.CoinUseCase, alertUseCase *usecase.AlertUseCase, portfolioUseCase *usecase.PortfolioUseCase, prices <-chan entities.PriceUpdated, alerts <-chan entities.AlertTriggered, logger *slog.Logger, token string) (*Bot, error) {
 
 	bot, err := tgbotapi.NewBotAPI(token)
 	if err != nil {
@@ -31,11 +37,16 @@ func NewBot(userUseCase *usecase.UserUseCase, currencyUseCase *usecase.CurrencyU
 	}
 
 	return &Bot{
-		userUseCase:     userUseCase,
-		currencyUseCase: currencyUseCase,
-		lastSentMap:     map[int64]time.Time{},
-		Api:             bot,
-		logger:          logger,
+		userUseCase:      userUseCase,
+		currencyUseCase:  currencyUseCase,
+		coinUseCase:      coinUseCase,
+		alertUseCase:     alertUseCase,
+		portfolioUseCase: portfolioUseCase,
+		prices:           prices,
+		alerts:           alerts,
+		lastSentMap:      map[int64]time.Time{},
+		Api:              bot,
+		logger:           logger,
 	}, nil
 }
 
@@ -50,6 +61,7 @@ func (b *Bot) Run(ctx context.Context) {
 	updates := b.Api.GetUpdatesChan(u)
 
 	go b.sendUpdates(ctx)
+	go b.sendAlerts(ctx)
 
 	for {
 		select {
@@ -57,11 +69,14 @@ func (b *Bot) Run(ctx context.Context) {
 			b.logger.Info("Telegram bot stopped")
 			return
 		case update := <-updates:
-			if update.Message == nil {
-				continue
+			switch {
+			case update.InlineQuery != nil:
+				b.handleInlineQuery(ctx, update.InlineQuery)
+			case update.CallbackQuery != nil:
+				b.handleCallback(ctx, update.CallbackQuery)
+			case update.Message != nil:
+				b.handleMessage(ctx, update.Message)
 			}
-
-			b.handleMessage(ctx, update.Message)
 		}
 	}
 }
@@ -75,19 +90,43 @@ func (b *Bot) handleMessage(ctx context.Context, message *tgbotapi.Message) {
 
 	switch message.Command() {
 	case "start":
-		b.handleStart(message)
+		b.handleStart(ctx, message)
 	case "rates":
 		b.handleRates(ctx, message)
+	case "candles":
+		b.handleCandles(ctx, message)
 	case "coins":
-		b.handleCoins(message)
+		b.handleCoins(ctx, message)
+	case "quote":
+		b.handleQuote(ctx, message)
+	case "watch":
+		b.handleWatch(ctx, message)
+	case "unwatch":
+		b.handleUnwatch(ctx, message)
+	case "alert":
+		b.handleAlert(ctx, message)
+	case "alerts":
+		b.handleAlerts(ctx, message)
+	case "alert_del":
+		b.handleAlertDelete(ctx, message)
+	case "buy":
+		b.handleBuy(ctx, message)
+	case "sell":
+		b.handleSell(ctx, message)
+	case "portfolio":
+		b.handlePortfolio(ctx, message)
+	case "token":
+		b.handleToken(ctx, message)
 	case "start_auto":
 		b.handleStartAuto(ctx, message)
 	case "stop_auto":
 		b.handleStopAuto(ctx, message)
+	case "lang":
+		b.handleLang(ctx, message)
 	case "help":
-		b.handleHelp(message)
+		b.handleHelp(ctx, message)
 	default:
-		b.sendMessage(message.Chat.ID, "❌ Неизвестная команда. Выберите команду из доступных ниже.")
-		b.handleHelp(message)
+		b.sendMessage(message.Chat.ID, b.localizer(ctx, message.Chat.ID, message.From).T("unknown_command"))
+		b.handleHelp(ctx, message)
 	}
 }
@@ -0,0 +1,251 @@
+package telegram
+
+import (
+	"context"
+	"currencyhub/internal/entities"
+	"currencyhub/internal/infrastructure/i18n"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"strings"
+)
+
+// Callback actions encoded as first part of inline button data
+const (
+	callbackRate     = "rate"     // Show rate card of coin in new message
+	callbackRefresh  = "refresh"  // Update rate card in place
+	callbackCandles  = "candles"  // Choose candle interval or show candles of coin
+	callbackStopAuto = "stopauto" // Confirm or cancel disabling auto-updates
+	callbackLang     = "lang"     // Choose interface language
+)
+
+// callbackDataLimit is maximum length of inline button data allowed by Telegram
+const callbackDataLimit = 64
+
+// keyboardCoins limits number of coins offered in coin picker
+const keyboardCoins = 48
+
+// keyboardColumns is number of buttons in coin picker row
+const keyboardColumns = 3
+
+// candleIntervals are intervals offered by candles keyboard
+var candleIntervals = []entities.CandleInterval{entities.Interval1m, entities.Interval5m, entities.Interval1h, entities.Interval1d}
+
+// handleCallback processes inline keyboard button presses
+// Every callback query is answered so that client stops showing progress
+func (b *Bot) handleCallback(ctx context.Context, query *tgbotapi.CallbackQuery) {
+	if query.Message == nil {
+		b.answerCallback(query.ID, "")
+		return
+	}
+
+	loc := b.localizer(ctx, query.Message.Chat.ID, query.From)
+	action, args := parseCallback(query.Data)
+	arg := ""
+	if len(args) > 0 {
+		arg = args[0]
+	}
+	switch action {
+	case callbackRate:
+		b.answerCallback(query.ID, "")
+		b.handleRateCallback(ctx, loc, query.Message, arg, false)
+	case callbackRefresh:
+		b.answerCallback(query.ID, loc.T("callback.refreshed"))
+		b.handleRateCallback(ctx, loc, query.Message, arg, true)
+	case callbackCandles:
+		b.answerCallback(query.ID, "")
+		b.handleCandlesCallback(ctx, loc, query.Message, args)
+	case callbackStopAuto:
+		b.answerCallback(query.ID, "")
+		b.handleStopAutoCallback(ctx, loc, query.Message, arg == "yes")
+	case callbackLang:
+		b.answerCallback(query.ID, "")
+		b.handleLangCallback(ctx, query.Message, arg)
+	default:
+		b.answerCallback(query.ID, loc.T("callback.expired"))
+	}
+}
+
+// handleRateCallback shows rate card of picked coin
+// Refresh replaces card in place, pick sends new card
+func (b *Bot) handleRateCallback(ctx context.Context, loc i18n.Localizer, message *tgbotapi.Message, coinID string, refresh bool) {
+	coin, ok := b.resolveCoin(ctx, loc, message.Chat.ID, coinID)
+	if !ok {
+		return
+	}
+	card := b.rateCard(ctx, loc, coin, b.userQuote(ctx, message.Chat.ID))
+	if refresh {
+		b.editMessage(message.Chat.ID, message.MessageID, card, rateKeyboard(loc, coin.ID))
+		return
+	}
+	b.sendMessageWithKeyboard(message.Chat.ID, card, rateKeyboard(loc, coin.ID))
+}
+
+// handleCandlesCallback turns coin picker into interval choice or shows candles of chosen interval
+// Arguments are coin and optional interval
+func (b *Bot) handleCandlesCallback(ctx context.Context, loc i18n.Localizer, message *tgbotapi.Message, args []string) {
+	if len(args) == 0 {
+		return
+	}
+	coinID := args[0]
+	if len(args) == 1 {
+		b.editMessage(message.Chat.ID, message.MessageID, loc.T("candles.interval", coinID), candlesKeyboard(coinID, ""))
+		return
+	}
+
+	interval := entities.CandleInterval(args[1])
+	if !interval.IsValid() {
+		return
+	}
+	card := b.candlesCard(ctx, loc, coinID, b.userQuote(ctx, message.Chat.ID), interval)
+	b.editMessage(message.Chat.ID, message.MessageID, card, candlesKeyboard(coinID, interval))
+}
+
+// handleStopAutoCallback disables auto-updates once user confirms
+func (b *Bot) handleStopAutoCallback(ctx context.Context, loc i18n.Localizer, message *tgbotapi.Message, confirmed bool) {
+	if !confirmed {
+		b.editMessage(message.Chat.ID, message.MessageID, loc.T("auto.kept"), noKeyboard())
+		return
+	}
+
+	if err := b.userUseCase.DisableAutoSubscribe(ctx, message.Chat.ID); err != nil {
+		b.logger.Error("Failed to disable auto subscribe", "error", err)
+		b.editMessage(message.Chat.ID, message.MessageID, loc.T("auto.disable_error"), noKeyboard())
+		return
+	}
+	b.editMessage(message.Chat.ID, message.MessageID, loc.T("auto.disabled"), noKeyboard())
+}
+
+// handleLangCallback stores picked interface language and confirms it in that language
+func (b *Bot) handleLangCallback(ctx context.Context, message *tgbotapi.Message, language string) {
+	loc := translator.Localizer(language)
+	if err := b.userUseCase.SetUserLanguage(ctx, message.Chat.ID, language); err != nil {
+		b.logger.Error("Failed to set user language", "error", err)
+		b.editMessage(message.Chat.ID, message.MessageID, loc.T("error.settings"), noKeyboard())
+		return
+	}
+	b.editMessage(message.Chat.ID, message.MessageID, loc.T("lang.changed"), noKeyboard())
+}
+
+// callbackData encodes button action with arguments
+// Returns false when data does not fit Telegram limit
+func callbackData(action string, args ...string) (string, bool) {
+	data := strings.Join(append([]string{action}, args...), ":")
+	return data, len(data) <= callbackDataLimit
+}
+
+// parseCallback splits button data into action and arguments
+func parseCallback(data string) (string, []string) {
+	parts := strings.Split(data, ":")
+	return parts[0], parts[1:]
+}
+
+// coinKeyboard builds coin picker with buttons leading to given action
+// Coins whose identifier does not fit button data are skipped
+func coinKeyboard(coins []*entities.Coin, action string) tgbotapi.InlineKeyboardMarkup {
+	var rows [][]tgbotapi.InlineKeyboardButton
+	var row []tgbotapi.InlineKeyboardButton
+	added := 0
+	for _, coin := range coins {
+		data, ok := callbackData(action, coin.ID)
+		if !ok || added == keyboardCoins {
+			continue
+		}
+		row = append(row, tgbotapi.NewInlineKeyboardButtonData(coin.Symbol, data))
+		added++
+		if len(row) == keyboardColumns {
+			rows = append(rows, row)
+			row = nil
+		}
+	}
+	if len(row) > 0 {
+		rows = append(rows, row)
+	}
+	return tgbotapi.NewInlineKeyboardMarkup(rows...)
+}
+
+// rateKeyboard builds rate card buttons
+// Buttons whose data or follow-up interval data does not fit Telegram limit are left out
+func rateKeyboard(loc i18n.Localizer, coinID string) tgbotapi.InlineKeyboardMarkup {
+	var row []tgbotapi.InlineKeyboardButton
+	if data, ok := callbackData(callbackRefresh, coinID); ok {
+		row = append(row, tgbotapi.NewInlineKeyboardButtonData(loc.T("button.refresh"), data))
+	}
+	if _, ok := callbackData(callbackCandles, coinID, string(entities.Interval1m)); ok {
+		row = append(row, tgbotapi.NewInlineKeyboardButtonData(loc.T("button.candles"), callbackCandles+":"+coinID))
+	}
+	return keyboardOf(row)
+}
+
+// candlesKeyboard builds interval choice with current interval marked
+// Intervals whose data does not fit Telegram limit are left out
+func candlesKeyboard(coinID string, current entities.CandleInterval) tgbotapi.InlineKeyboardMarkup {
+	row := make([]tgbotapi.InlineKeyboardButton, 0, len(candleIntervals))
+	for _, interval := range candleIntervals {
+		data, ok := callbackData(callbackCandles, coinID, string(interval))
+		if !ok {
+			continue
+		}
+		label := string(interval)
+		if interval == current {
+			label = "• " + label
+		}
+		row = append(row, tgbotapi.NewInlineKeyboardButtonData(label, data))
+	}
+	return keyboardOf(row)
+}
+
+// keyboardOf builds single row keyboard, empty keyboard for empty row
+func keyboardOf(row []tgbotapi.InlineKeyboardButton) tgbotapi.InlineKeyboardMarkup {
+	if len(row) == 0 {
+		return noKeyboard()
+	}
+	return tgbotapi.NewInlineKeyboardMarkup(row)
+}
+
+// stopAutoKeyboard builds confirmation of disabling auto-updates
+func stopAutoKeyboard(loc i18n.Localizer) tgbotapi.InlineKeyboardMarkup {
+	return tgbotapi.NewInlineKeyboardMarkup(tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(loc.T("button.stop_auto"), callbackStopAuto+":yes"),
+		tgbotapi.NewInlineKeyboardButtonData(loc.T("button.cancel"), callbackStopAuto+":no"),
+	))
+}
+
+// languageKeyboard builds choice of interface language, every language named in itself
+func languageKeyboard() tgbotapi.InlineKeyboardMarkup {
+	row := make([]tgbotapi.InlineKeyboardButton, 0, len(entities.Languages))
+	for _, language := range entities.Languages {
+		row = append(row, tgbotapi.NewInlineKeyboardButtonData(translator.Localizer(language).T("lang.name"), callbackLang+":"+language))
+	}
+	return tgbotapi.NewInlineKeyboardMarkup(row)
+}
+
+// noKeyboard removes inline keyboard from edited message
+func noKeyboard() tgbotapi.InlineKeyboardMarkup {
+	return tgbotapi.InlineKeyboardMarkup{InlineKeyboard: [][]tgbotapi.InlineKeyboardButton{}}
+}
+
+// sendMessageWithKeyboard sends text message with inline keyboard to Telegram chat
+func (b *Bot) sendMessageWithKeyboard(chatID int64, text string, keyboard tgbotapi.InlineKeyboardMarkup) {
+	msg := tgbotapi.NewMessage(chatID, text)
+	if len(keyboard.InlineKeyboard) > 0 {
+		msg.ReplyMarkup = keyboard
+	}
+	if _, err := b.Api.Send(msg); err != nil {
+		b.logger.Error("Failed to send message", "error", err)
+	}
+}
+
+// editMessage replaces text and inline keyboard of sent message
+// Unchanged content is not an error, e.g. refresh before price changes
+func (b *Bot) editMessage(chatID int64, messageID int, text string, keyboard tgbotapi.InlineKeyboardMarkup) {
+	edit := tgbotapi.NewEditMessageTextAndMarkup(chatID, messageID, text, keyboard)
+	if _, err := b.Api.Request(edit); err != nil && !strings.Contains(err.Error(), "message is not modified") {
+		b.logger.Error("Failed to edit message", "error", err)
+	}
+}
+
+// answerCallback acknowledges callback query with optional notification text
+func (b *Bot) answerCallback(queryID, text string) {
+	if _, err := b.Api.Request(tgbotapi.NewCallback(queryID, text)); err != nil {
+		b.logger.Error("Failed to answer callback query", "error", err)
+	}
+}
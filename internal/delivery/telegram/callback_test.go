@@ -0,0 +1,76 @@
+package telegram
+
+import (
+	"currencyhub/internal/entities"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestParseCallback(t *testing.T) {
+	action, args := parseCallback("candles:bitcoin:5m")
+	assert.Equal(t, callbackCandles, action)
+	assert.Equal(t, []string{"bitcoin", "5m"}, args)
+
+	action, args = parseCallback("stopauto")
+	assert.Equal(t, callbackStopAuto, action)
+	assert.Empty(t, args)
+}
+
+func TestCallbackData(t *testing.T) {
+	data, ok := callbackData(callbackRefresh, "bitcoin")
+	assert.True(t, ok)
+	assert.Equal(t, "refresh:bitcoin", data)
+
+	_, ok = callbackData(callbackCandles, strings.Repeat("a", 55), "1m")
+	assert.False(t, ok)
+}
+
+func TestKeyboards_DataLimit(t *testing.T) {
+	loc := translator.Localizer("en")
+	long := strings.Repeat("a", 56)
+	coins := []*entities.Coin{{ID: "bitcoin", Symbol: "BTC"}, {ID: strings.Repeat("a", 60), Symbol: "LONG"}}
+
+	keyboards := map[string][][]string{
+		"coins":       dataOf(coinKeyboard(coins, callbackRate).InlineKeyboard),
+		"rate":        dataOf(rateKeyboard(loc, "bitcoin").InlineKeyboard),
+		"long rate":   dataOf(rateKeyboard(loc, long).InlineKeyboard),
+		"candles":     dataOf(candlesKeyboard("bitcoin", entities.Interval1h).InlineKeyboard),
+		"long candle": dataOf(candlesKeyboard(long, entities.Interval1h).InlineKeyboard),
+	}
+	for name, rows := range keyboards {
+		for _, row := range rows {
+			assert.NotEmpty(t, row, name)
+			for _, data := range row {
+				assert.LessOrEqual(t, len(data), callbackDataLimit, name)
+			}
+		}
+	}
+
+	assert.Equal(t, [][]string{{"rate:bitcoin"}}, keyboards["coins"])
+	assert.Equal(t, [][]string{{"refresh:bitcoin", "candles:bitcoin"}}, keyboards["rate"])
+	assert.Equal(t, [][]string{{"refresh:" + long}}, keyboards["long rate"])
+	assert.Len(t, keyboards["candles"][0], len(candleIntervals))
+	assert.Empty(t, keyboards["long candle"])
+}
+
+func TestCandlesKeyboard_MarksCurrent(t *testing.T) {
+	row := candlesKeyboard("bitcoin", entities.Interval5m).InlineKeyboard[0]
+	assert.Equal(t, "1m", row[0].Text)
+	assert.Equal(t, "• 5m", row[1].Text)
+	assert.Equal(t, "candles:bitcoin:5m", *row[1].CallbackData)
+}
+
+// dataOf returns callback data of keyboard buttons
+func dataOf(rows [][]tgbotapi.InlineKeyboardButton) [][]string {
+	result := make([][]string, 0, len(rows))
+	for _, row := range rows {
+		data := make([]string, 0, len(row))
+		for _, button := range row {
+			data = append(data, *button.CallbackData)
+		}
+		result = append(result, data)
+	}
+	return result
+}
@@ -5,91 +5,388 @@ package telegram
 import (
 	"context"
 	"currencyhub/internal/entities"
+	"currencyhub/internal/infrastructure/i18n"
+	"errors"
 	"fmt"
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 )
 
-// handleStart processes /start command - welcomes user and shows available commands
-func (b *Bot) handleStart(message *tgbotapi.Message) {
+// candlesInMessage limits number of candles shown by /candles command
+const candlesInMessage = 12
 
-	msg := `🤖 💰 Добро пожаловать в Currency Hub Bot! 
+// intervalPattern matches /candles argument written as interval, e.g. 15m or 4h
+var intervalPattern = regexp.MustCompile(`^[0-9]+[a-z]$`)
 
-📋 Доступные команды:
-/rates - показать все курсы валют 📊
-/rates [валюта] - показать курс конкретной валюты 📈
-/coins - список всех доступных валют 🪙
-/start_auto [мин] - запустить автоподписку 🔔
-/stop_auto - остановить автоподписку 🔕
-/help - показать справку ❓`
+// updateSettleDelay is pause after first fresh price before auto-updates are sent
+const updateSettleDelay = 5 * time.Second
 
-	b.sendMessage(message.Chat.ID, msg)
+// handleStart processes /start command - welcomes user and shows available commands
+func (b *Bot) handleStart(ctx context.Context, message *tgbotapi.Message) {
+	loc := b.localizer(ctx, message.Chat.ID, message.From)
+	b.sendMessage(message.Chat.ID, loc.T("start"))
 }
 
 // HandleRates processes /rates command - shows currency rates (all or specific)
 func (b *Bot) handleRates(ctx context.Context, message *tgbotapi.Message) {
-	args := strings.Split(message.Text, " ")
-	if len(args) > 1 {
-		currencyID := strings.ToLower(args[1])
-		isExist := b.currencyUseCase.CheckList(currencyID)
-		if !isExist {
-			b.sendMessage(message.Chat.ID, "❌ Валюта не найдена")
+	loc := b.localizer(ctx, message.Chat.ID, message.From)
+	quote := b.userQuote(ctx, message.Chat.ID)
+	if query := strings.TrimSpace(message.CommandArguments()); query != "" {
+		coin, ok := b.resolveCoin(ctx, loc, message.Chat.ID, query)
+		if !ok {
 			return
 		}
-		rate, err := b.currencyUseCase.GetLatestByCurrency(ctx, currencyID)
+		b.sendMessageWithKeyboard(message.Chat.ID, b.rateCard(ctx, loc, coin, quote), rateKeyboard(loc, coin.ID))
+	} else {
+		watchlist, err := b.userUseCase.GetWatchlist(ctx, message.Chat.ID)
+		if err != nil {
+			b.logger.Error("Failed to get watchlist", "error", err)
+		}
+		rates, err := b.currencyUseCase.GetWatchlistRates(ctx, quote, watchlist)
 		if err != nil {
-			b.sendMessage(message.Chat.ID, "❌ Ошибка при получении, попробуйте позже")
+			b.logger.Error("Failed to get rates", "error", err)
+			b.sendMessage(message.Chat.ID, loc.T("rates.error"))
 			return
 		}
 
+		var msg strings.Builder
+		if len(watchlist) > 0 {
+			msg.WriteString(loc.T("rates.watchlist"))
+		} else {
+			msg.WriteString(loc.T("rates.title"))
+		}
+		for _, rate := range rates {
+			msg.WriteString(formatRateLine(loc, rate, quote))
+		}
+		b.sendMessage(message.Chat.ID, msg.String())
+	}
+}
+
+// rateCard builds rate message of coin in given quote currency
+// Failures are logged and reported in returned text
+func (b *Bot) rateCard(ctx context.Context, loc i18n.Localizer, coin *entities.Coin, quote string) string {
+	rate, err := b.currencyUseCase.GetLatestByCurrency(ctx, coin.ID, quote)
+	if errors.Is(err, entities.ErrCurrencyNotFound) {
+		return loc.T("rate.missing", coin.Symbol, strings.ToUpper(quote))
+	}
+	if err != nil {
+		b.logger.Error("Failed to get currency rate", "currency", coin.ID, "error", err)
+		return loc.T("error.fetch")
+	}
+	return formatRateCard(loc, rate, quote)
+}
+
+// formatRateCard formats rate card with daily range and signed changes
+func formatRateCard(loc i18n.Localizer, rate *entities.CurrencyRate, quote string) string {
+	msg := loc.T("rate.card",
+		rate.CurrencyID, loc.Price(rate.CurrentPrice, quote), loc.Price(rate.MinPrice, quote),
+		loc.Price(rate.MaxPrice, quote),
+		trendEmoji(rate.Change1h), loc.Percent(rate.Change1h),
+		trendEmoji(rate.Change24h), loc.Percent(rate.Change24h),
+		trendEmoji(rate.Change7d), loc.Percent(rate.Change7d))
+	if rate.Stale {
+		msg += loc.T("rate.stale")
+	}
+	return msg + "\n🕰 " + rate.TimeStamp.UTC().Format("02.01 15:04:05 UTC")
+}
+
+// handleCandles processes /candles command - shows recent OHLC candles for currency
+func (b *Bot) handleCandles(ctx context.Context, message *tgbotapi.Message) {
+	loc := b.localizer(ctx, message.Chat.ID, message.From)
+	args := strings.Fields(message.Text)
+	if len(args) < 2 {
+		b.sendCoinPicker(ctx, loc, message.Chat.ID, loc.T("candles.pick"), callbackCandles)
+		return
+	}
+
+	query, interval, ok := parseCandles(args[1:])
+	if !ok {
+		b.sendMessage(message.Chat.ID, loc.T("candles.bad_interval"))
+		return
+	}
+
+	coin, ok := b.resolveCoin(ctx, loc, message.Chat.ID, query)
+	if !ok {
+		return
+	}
+
+	card := b.candlesCard(ctx, loc, coin.ID, b.userQuote(ctx, message.Chat.ID), interval)
+	b.sendMessageWithKeyboard(message.Chat.ID, card, candlesKeyboard(coin.ID, interval))
+}
+
+// parseCandles reads coin query and interval from /candles arguments
+// Last argument is interval only when it looks like one, so coin names may contain spaces
+func parseCandles(args []string) (string, entities.CandleInterval, bool) {
+	last := strings.ToLower(args[len(args)-1])
+	if len(args) < 2 || !intervalPattern.MatchString(last) {
+		return strings.Join(args, " "), entities.Interval1h, true
+	}
+	interval := entities.CandleInterval(last)
+	return strings.Join(args[:len(args)-1], " "), interval, interval.IsValid()
+}
+
+// candlesCard builds message with recent OHLC candles of coin
+// Failures are logged and reported in returned text
+func (b *Bot) candlesCard(ctx context.Context, loc i18n.Localizer, currencyID, quote string, interval entities.CandleInterval) string {
+	to := time.Now().UTC()
+	from := to.Add(-candlesInMessage * interval.Duration())
+	candles, err := b.currencyUseCase.GetCandles(ctx, currencyID, quote, interval, from, to)
+	if err != nil {
+		b.logger.Error("Failed to get candles", "currency", currencyID, "error", err)
+		return loc.T("error.fetch")
+	}
+	if len(candles) == 0 {
+		return loc.T("candles.empty", currencyID, interval)
+	}
+
+	layout := "15:04"
+	if interval == entities.Interval1d {
+		layout = "02.01"
+	}
+
+	var msg strings.Builder
+	msg.WriteString(loc.T("candles.title", currencyID, interval))
+	for _, candle := range candles {
 		trendEmoji := "➡️"
-		if rate.ChangePercent > 0 {
+		if candle.Close > candle.Open {
 			trendEmoji = "📈"
-		} else if rate.ChangePercent < 0 {
+		} else if candle.Close < candle.Open {
 			trendEmoji = "📉"
 		}
 
-		msg := fmt.Sprintf("💰 Курс %s:\n📊 Текущий: $%.2f\n📉 Мин. за день: $%.2f\n📈 Макс. за день: $%.2f\n%s Изменение за час: %.2f%%",
-			currencyID, rate.CurrentPrice, rate.MinPrice, rate.MaxPrice, trendEmoji, rate.ChangePercent)
-		b.sendMessage(message.Chat.ID, msg)
-	} else {
-		rates, err := b.currencyUseCase.GetRates(ctx)
-		if err != nil {
-			b.logger.Error("Failed to get rates", "error", err)
-			b.sendMessage(message.Chat.ID, "❌ Ошибка получения курсов")
+		msg.WriteString(fmt.Sprintf("%s %s O: %s H: %s L: %s C: %s\n",
+			trendEmoji, candle.OpenTime.Format(layout), loc.Price(candle.Open, quote), loc.Price(candle.High, quote),
+			loc.Price(candle.Low, quote), loc.Price(candle.Close, quote)))
+	}
+	return msg.String()
+}
+
+// sendCoinPicker sends inline keyboard of tracked coins leading to given callback action
+func (b *Bot) sendCoinPicker(ctx context.Context, loc i18n.Localizer, chatID int64, text, action string) {
+	coins, err := b.coinUseCase.GetCoins(ctx)
+	if err != nil {
+		b.logger.Error("Failed to get coins", "error", err)
+		b.sendMessage(chatID, loc.T("coins.error"))
+		return
+	}
+	b.sendMessageWithKeyboard(chatID, text, coinKeyboard(coins, action))
+}
+
+// resolveCoin finds tracked coin by identifier, ticker, name or alias
+// Replies with closest matches and returns false when nothing matches
+func (b *Bot) resolveCoin(ctx context.Context, loc i18n.Localizer, chatID int64, query string) (*entities.Coin, bool) {
+	coin, err := b.coinUseCase.Resolve(ctx, query)
+	if err == nil {
+		return coin, true
+	}
+
+	var notFound *entities.CoinNotFoundError
+	if !errors.As(err, &notFound) {
+		b.logger.Error("Failed to resolve coin", "query", query, "error", err)
+		b.sendMessage(chatID, loc.T("error.fetch"))
+		return nil, false
+	}
+
+	msg := loc.T("coin.not_found")
+	if len(notFound.Suggestions) > 0 {
+		msg += loc.T("coin.did_you_mean", strings.Join(notFound.Suggestions, ", "))
+	}
+	b.sendMessage(chatID, msg)
+	return nil, false
+}
+
+// HandleCoins processes /coins command - shows available cryptocurrencies
+func (b *Bot) handleCoins(ctx context.Context, message *tgbotapi.Message) {
+	loc := b.localizer(ctx, message.Chat.ID, message.From)
+	coins, err := b.coinUseCase.GetCoins(ctx)
+	if err != nil {
+		b.logger.Error("Failed to get coins", "error", err)
+		b.sendMessage(message.Chat.ID, loc.T("coins.error"))
+		return
+	}
+
+	var msg strings.Builder
+	msg.WriteString(loc.T("coins.title"))
+	for _, coin := range coins {
+		msg.WriteString(fmt.Sprintf("%s - %s (%s)\n", coin.ID, coin.Name, coin.Symbol))
+	}
+	msg.WriteString(loc.T("coins.pick"))
+	b.sendMessageWithKeyboard(message.Chat.ID, msg.String(), coinKeyboard(coins, callbackRate))
+}
+
+// handleQuote processes /quote command - shows or changes currency prices are displayed in
+func (b *Bot) handleQuote(ctx context.Context, message *tgbotapi.Message) {
+	loc := b.localizer(ctx, message.Chat.ID, message.From)
+	codes := b.userUseCase.Quotes()
+
+	args := strings.Fields(message.Text)
+	if len(args) < 2 {
+		quote := b.userQuote(ctx, message.Chat.ID)
+		b.sendMessage(message.Chat.ID, loc.T("quote.current", strings.ToUpper(quote), strings.Join(codes, ", ")))
+		return
+	}
+
+	err := b.userUseCase.SetUserQuote(ctx, message.Chat.ID, args[1])
+	if errors.Is(err, entities.ErrUnsupportedQuote) {
+		b.sendMessage(message.Chat.ID, loc.T("quote.unknown", strings.Join(codes, ", ")))
+		return
+	}
+	if err != nil {
+		b.logger.Error("Failed to set user quote", "error", err)
+		b.sendMessage(message.Chat.ID, loc.T("error.settings"))
+		return
+	}
+
+	b.sendMessage(message.Chat.ID, loc.T("quote.changed", strings.ToUpper(args[1])))
+}
+
+// handleLang processes /lang command - shows or changes interface language
+// "auto" makes language follow Telegram client settings again
+func (b *Bot) handleLang(ctx context.Context, message *tgbotapi.Message) {
+	loc := b.localizer(ctx, message.Chat.ID, message.From)
+	arg := strings.ToLower(strings.TrimSpace(message.CommandArguments()))
+	if arg == "" {
+		b.sendMessageWithKeyboard(message.Chat.ID,
+			loc.T("lang.current", loc.T("lang.name"), strings.Join(entities.Languages, ", ")), languageKeyboard())
+		return
+	}
+
+	if arg == "auto" {
+		arg = ""
+	}
+	err := b.userUseCase.SetUserLanguage(ctx, message.Chat.ID, arg)
+	if errors.Is(err, entities.ErrInvalidArgument) {
+		b.sendMessage(message.Chat.ID, loc.T("lang.unknown", strings.Join(entities.Languages, ", ")))
+		return
+	}
+	if err != nil {
+		b.logger.Error("Failed to set user language", "error", err)
+		b.sendMessage(message.Chat.ID, loc.T("error.settings"))
+		return
+	}
+
+	if arg == "" {
+		b.sendMessage(message.Chat.ID, b.localizer(ctx, message.Chat.ID, message.From).T("lang.auto"))
+		return
+	}
+	language, _ := entities.LookupLanguage(arg)
+	b.sendMessage(message.Chat.ID, translator.Localizer(language).T("lang.changed"))
+}
+
+// handleWatch processes /watch command - shows watchlist or adds coins to it
+func (b *Bot) handleWatch(ctx context.Context, message *tgbotapi.Message) {
+	loc := b.localizer(ctx, message.Chat.ID, message.From)
+	queries := coinQueries(message.CommandArguments())
+	if len(queries) == 0 {
+		b.sendWatchlist(ctx, loc, message.Chat.ID)
+		return
+	}
+
+	coinIDs, ok := b.resolveCoins(ctx, loc, message.Chat.ID, queries)
+	if !ok {
+		return
+	}
+	if err := b.userUseCase.Watch(ctx, message.Chat.ID, coinIDs); err != nil {
+		b.logger.Error("Failed to update watchlist", "error", err)
+		b.sendMessage(message.Chat.ID, loc.T("watchlist.save_error"))
+		return
+	}
+	b.sendWatchlist(ctx, loc, message.Chat.ID)
+}
+
+// handleUnwatch processes /unwatch command - removes coins from watchlist or clears it
+func (b *Bot) handleUnwatch(ctx context.Context, message *tgbotapi.Message) {
+	loc := b.localizer(ctx, message.Chat.ID, message.From)
+	var coinIDs []string
+	if queries := coinQueries(message.CommandArguments()); len(queries) > 0 {
+		var ok bool
+		if coinIDs, ok = b.resolveCoins(ctx, loc, message.Chat.ID, queries); !ok {
 			return
 		}
+	}
 
-		var msg strings.Builder
-		msg.WriteString("📊 Текущие курсы:\n\n")
-		for _, rate := range rates {
-			trendEmoji := "➡️"
-			if rate.ChangePercent > 0 {
-				trendEmoji = "📈"
-			} else if rate.ChangePercent < 0 {
-				trendEmoji = "📉"
-			}
+	if err := b.userUseCase.Unwatch(ctx, message.Chat.ID, coinIDs); err != nil {
+		b.logger.Error("Failed to update watchlist", "error", err)
+		b.sendMessage(message.Chat.ID, loc.T("watchlist.save_error"))
+		return
+	}
+	b.sendWatchlist(ctx, loc, message.Chat.ID)
+}
+
+// sendWatchlist shows user's watchlist
+func (b *Bot) sendWatchlist(ctx context.Context, loc i18n.Localizer, chatID int64) {
+	watchlist, err := b.userUseCase.GetWatchlist(ctx, chatID)
+	if err != nil {
+		b.logger.Error("Failed to get watchlist", "error", err)
+		b.sendMessage(chatID, loc.T("watchlist.error"))
+		return
+	}
+	if len(watchlist) == 0 {
+		b.sendMessage(chatID, loc.T("watchlist.empty"))
+		return
+	}
+	b.sendMessage(chatID, loc.T("watchlist.list", strings.Join(watchlist, ", ")))
+}
 
-			msg.WriteString(fmt.Sprintf("💰 %s: $%.2f %s(%.2f%%)\n",
-				rate.CurrencyID, rate.CurrentPrice, trendEmoji, rate.ChangePercent))
+// coinQueries splits command arguments into coin queries
+// Comma separated list allows names with spaces, e.g. Bitcoin Cash
+func coinQueries(args string) []string {
+	if !strings.Contains(args, ",") {
+		return strings.Fields(args)
+	}
+	var queries []string
+	for _, query := range strings.Split(args, ",") {
+		if query = strings.TrimSpace(query); query != "" {
+			queries = append(queries, query)
 		}
-		b.sendMessage(message.Chat.ID, msg.String())
 	}
+	return queries
 }
 
-// HandleCoins processes /coins command - shows available cryptocurrencies
-func (b *Bot) handleCoins(message *tgbotapi.Message) {
+// resolveCoins finds tracked coins for every query
+// Replies with closest matches and returns false when any query matches nothing
+func (b *Bot) resolveCoins(ctx context.Context, loc i18n.Localizer, chatID int64, queries []string) ([]string, bool) {
+	coinIDs := make([]string, 0, len(queries))
+	for _, query := range queries {
+		coin, ok := b.resolveCoin(ctx, loc, chatID, query)
+		if !ok {
+			return nil, false
+		}
+		coinIDs = append(coinIDs, coin.ID)
+	}
+	return coinIDs, true
+}
 
-	coins := entities.CurrencyList
+// userQuote returns quote currency preferred by user
+// Falls back to default quote when preference cannot be read
+func (b *Bot) userQuote(ctx context.Context, userID int64) string {
+	quote, err := b.userUseCase.GetUserQuote(ctx, userID)
+	if err != nil {
+		b.logger.Error("Failed to get user quote", "error", err)
+	}
+	return quote
+}
 
-	msg := "📋 Доступные валюты:\n" + strings.Join(coins, "\n")
-	b.sendMessage(message.Chat.ID, msg)
+// localizer returns localizer in language of chat
+// Language of Telegram client of sender is used until user chooses one with /lang
+func (b *Bot) localizer(ctx context.Context, chatID int64, from *tgbotapi.User) i18n.Localizer {
+	clientLanguage := ""
+	if from != nil {
+		clientLanguage = from.LanguageCode
+	}
+	language, err := b.userUseCase.GetUserLanguage(ctx, chatID, clientLanguage)
+	if err != nil {
+		b.logger.Error("Failed to get user language", "error", err)
+	}
+	return translator.Localizer(language)
 }
 
 // HandleStartAuto processes /start_auto command - enables automatic updates with time choice option
 func (b *Bot) handleStartAuto(ctx context.Context, message *tgbotapi.Message) {
+	loc := b.localizer(ctx, message.Chat.ID, message.From)
 	args := strings.Split(message.Text, " ")
 	interval := uint(10)
 
@@ -97,7 +394,7 @@ func (b *Bot) handleStartAuto(ctx context.Context, message *tgbotapi.Message) {
 		if minutes, err := strconv.Atoi(args[1]); err == nil && minutes > 0 {
 			interval = uint(minutes)
 		} else if minutes, err := strconv.Atoi(args[1]); err == nil && minutes < 1 {
-			b.sendMessage(message.Chat.ID, "❌ Неверный интервал. Используйте число больше 0")
+			b.sendMessage(message.Chat.ID, loc.T("auto.bad_interval"))
 			return
 		}
 	}
@@ -105,39 +402,24 @@ func (b *Bot) handleStartAuto(ctx context.Context, message *tgbotapi.Message) {
 	err := b.userUseCase.SetAutoSubscribe(ctx, message.Chat.ID, interval)
 	if err != nil {
 		b.logger.Error("Failed to set auto subscribe", "error", err)
-		b.sendMessage(message.Chat.ID, "❌ Ошибка включения автоподписки")
+		b.sendMessage(message.Chat.ID, loc.T("auto.enable_error"))
 		return
 	}
 
-	msg := fmt.Sprintf("🔔 Автоподписка включена. Частота обновлений (минуты): %d", interval)
-	b.sendMessage(message.Chat.ID, msg)
+	b.sendMessage(message.Chat.ID, loc.N("auto.enabled", int64(interval), interval))
 
 }
 
-// HandleStopAuto processes /stop_auto command - disables automatic updates
+// HandleStopAuto processes /stop_auto command - asks to confirm disabling automatic updates
 func (b *Bot) handleStopAuto(ctx context.Context, message *tgbotapi.Message) {
-	err := b.userUseCase.DisableAutoSubscribe(ctx, message.Chat.ID)
-	if err != nil {
-		b.logger.Error("Failed to disable auto subscribe", "error", err)
-		b.sendMessage(message.Chat.ID, "❌ Ошибка отключения автоподписки")
-		return
-	}
-
-	b.sendMessage(message.Chat.ID, "🔕 Автоподписка отключена")
+	loc := b.localizer(ctx, message.Chat.ID, message.From)
+	b.sendMessageWithKeyboard(message.Chat.ID, loc.T("auto.confirm"), stopAutoKeyboard(loc))
 }
 
 // handleHelp processes /help command - shows available commands
-func (b *Bot) handleHelp(message *tgbotapi.Message) {
-	msg := `🤖 💰 Доступные команды:
-
-📊 /rates - показать все курсы валют
-📈 /rates [валюта] - показать курс конкретной валюты
-🪙 /coins - список всех доступных валют
-🔔 /start_auto [мин] - запустить автоподписку
-🔕 /stop_auto - остановить автоподписку
-❓ /help - показать справку`
-
-	b.sendMessage(message.Chat.ID, msg)
+func (b *Bot) handleHelp(ctx context.Context, message *tgbotapi.Message) {
+	loc := b.localizer(ctx, message.Chat.ID, message.From)
+	b.sendMessage(message.Chat.ID, loc.T("help"))
 }
 
 // sendMessage sends text message to Telegram chat
@@ -149,16 +431,24 @@ func (b *Bot) sendMessage(chatID int64, text string) {
 	}
 }
 
-// sendUpdates periodically sends currency updates to subscribed users
+// sendUpdates sends currency updates to subscribed users when fresh prices arrive
+// Waits until update cycle settles so that users get all coins in one message
 func (b *Bot) sendUpdates(ctx context.Context) {
-	ticker := time.NewTicker(1 * time.Minute)
-	defer ticker.Stop()
+	var settled <-chan time.Time
 
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case <-ticker.C:
+		case _, ok := <-b.prices:
+			if !ok {
+				return
+			}
+			if settled == nil {
+				settled = time.After(updateSettleDelay)
+			}
+		case <-settled:
+			settled = nil
 			b.logger.Info("sending starts")
 			b.sendCurrencyUpdates(ctx)
 		}
@@ -166,6 +456,7 @@ func (b *Bot) sendUpdates(ctx context.Context) {
 }
 
 // sendCurrencyUpdates gets user with autoupdate setting and sends stats
+// Each user gets rates of coins from own watchlist in own quote currency and language
 func (b *Bot) sendCurrencyUpdates(ctx context.Context) {
 	users, err := b.userUseCase.GetSubscribedUsers(ctx)
 	if err != nil {
@@ -175,38 +466,61 @@ func (b *Bot) sendCurrencyUpdates(ctx context.Context) {
 		b.logger.Info("got subscribed users")
 	}
 
-	rates, err := b.currencyUseCase.GetRates(ctx)
-	if err != nil {
-		b.logger.Error("Failed to get currency rates", "error", err)
-		return
-	} else {
-		b.logger.Info("got currency rates")
+	ratesByQuote := make(map[string][]*entities.CurrencyRate)
+	now := time.Now()
+	for _, user := range users {
+		lastSent, exists := b.lastSentMap[user.TelegramID]
+		shouldSend := !exists || now.Sub(lastSent) >= time.Duration(user.SendInterval)*time.Minute
+		if !shouldSend {
+			continue
+		}
+
+		quote := user.Quote
+		rates, ok := ratesByQuote[quote]
+		if !ok {
+			rates, err = b.currencyUseCase.GetRates(ctx, quote)
+			if err != nil {
+				b.logger.Error("Failed to get currency rates", "quote", quote, "error", err)
+				continue
+			}
+			ratesByQuote[quote] = rates
+		}
+
+		b.logger.Info("sending update")
+		loc := translator.Localizer(user.Language)
+		b.sendMessage(user.TelegramID, formatUpdate(loc, entities.FilterRates(rates, user.Watchlist), quote))
+		b.lastSentMap[user.TelegramID] = now
 	}
+}
 
+// formatUpdate builds auto-update message with rates in given quote currency
+func formatUpdate(loc i18n.Localizer, rates []*entities.CurrencyRate, quote string) string {
 	var msg strings.Builder
-	msg.WriteString("🔔 Автообновление курсов:\n\n")
+	msg.WriteString(loc.T("update.title"))
 	for _, rate := range rates {
-		trendEmoji := "➡️"
-		if rate.ChangePercent > 0 {
-			trendEmoji = "📈"
-		} else if rate.ChangePercent < 0 {
-			trendEmoji = "📉"
-		}
+		msg.WriteString(formatRateLine(loc, rate, quote))
+	}
+	return msg.String()
+}
 
-		msg.WriteString(fmt.Sprintf("💰 %s: $%.2f %s(%.2f%%)\n",
-			rate.CurrencyID, rate.CurrentPrice, trendEmoji, rate.ChangePercent))
+// formatRateLine formats rate as list line with hourly and daily change
+func formatRateLine(loc i18n.Localizer, rate *entities.CurrencyRate, quote string) string {
+	staleMark := ""
+	if rate.Stale {
+		staleMark = " ⚠️"
 	}
-	message := msg.String()
+	return loc.T("rate.line",
+		rate.CurrencyID, loc.Price(rate.CurrentPrice, quote), trendEmoji(rate.Change24h),
+		loc.Percent(rate.Change1h), loc.Percent(rate.Change24h), staleMark)
+}
 
-	now := time.Now()
-	for userID, interval := range users {
-		lastSent, exists := b.lastSentMap[userID]
-		shouldSend := !exists || now.Sub(lastSent) >= time.Duration(interval)*time.Minute
-
-		if shouldSend {
-			b.logger.Info("sending update")
-			b.sendMessage(userID, message)
-			b.lastSentMap[userID] = now
-		}
+// trendEmoji returns emoji showing direction of price change
+func trendEmoji(change float64) string {
+	switch {
+	case change > 0:
+		return "📈"
+	case change < 0:
+		return "📉"
 	}
+	return "➡️"
 }
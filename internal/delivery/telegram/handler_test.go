@@ -0,0 +1,32 @@
+package telegram
+
+import (
+	"currencyhub/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseCandles(t *testing.T) {
+	tests := []struct {
+		args     []string
+		query    string
+		interval entities.CandleInterval
+		ok       bool
+	}{
+		{[]string{"bitcoin"}, "bitcoin", entities.Interval1h, true},
+		{[]string{"bitcoin", "5m"}, "bitcoin", entities.Interval5m, true},
+		{[]string{"bitcoin", "1D"}, "bitcoin", entities.Interval1d, true},
+		{[]string{"bitcoin", "cash"}, "bitcoin cash", entities.Interval1h, true},
+		{[]string{"bitcoin", "cash", "1m"}, "bitcoin cash", entities.Interval1m, true},
+		{[]string{"bitcoin", "2h"}, "bitcoin", "2h", false},
+	}
+
+	for _, tt := range tests {
+		query, interval, ok := parseCandles(tt.args)
+		assert.Equal(t, tt.ok, ok, tt.args)
+		if tt.ok {
+			assert.Equal(t, tt.query, query, tt.args)
+			assert.Equal(t, tt.interval, interval, tt.args)
+		}
+	}
+}
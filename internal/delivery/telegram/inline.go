@@ -0,0 +1,57 @@
+package telegram
+
+import (
+	"context"
+	"currencyhub/internal/entities"
+	"errors"
+	"fmt"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// inlineResults limits number of price cards offered for inline query
+const inlineResults = 10
+
+// inlineCacheSeconds is how long Telegram may reuse inline answer
+// Kept short since prices change on every fetch cycle
+const inlineCacheSeconds = 30
+
+// handleInlineQuery answers @bot queries with price cards of matching coins
+// Prices are shown in quote currency chosen by querying user
+func (b *Bot) handleInlineQuery(ctx context.Context, query *tgbotapi.InlineQuery) {
+	coins, err := b.coinUseCase.Search(ctx, query.Query, inlineResults)
+	if err != nil {
+		b.logger.Error("Failed to search coins", "query", query.Query, "error", err)
+		return
+	}
+
+	loc := b.localizer(ctx, query.From.ID, query.From)
+	quote := b.userQuote(ctx, query.From.ID)
+	results := make([]interface{}, 0, len(coins))
+	for _, coin := range coins {
+		rate, err := b.currencyUseCase.GetLatestByCurrency(ctx, coin.ID, quote)
+		if errors.Is(err, entities.ErrCurrencyNotFound) {
+			continue
+		}
+		if err != nil {
+			b.logger.Error("Failed to get currency rate", "currency", coin.ID, "error", err)
+			continue
+		}
+
+		article := tgbotapi.NewInlineQueryResultArticle(coin.ID+":"+quote,
+			fmt.Sprintf("%s (%s) %s", coin.Name, coin.Symbol, loc.Price(rate.CurrentPrice, quote)),
+			formatRateCard(loc, rate, quote))
+		article.Description = loc.T("inline.changes", trendEmoji(rate.Change24h),
+			loc.Percent(rate.Change1h), loc.Percent(rate.Change24h), loc.Percent(rate.Change7d))
+		results = append(results, article)
+	}
+
+	answer := tgbotapi.InlineConfig{
+		InlineQueryID: query.ID,
+		Results:       results,
+		CacheTime:     inlineCacheSeconds,
+		IsPersonal:    true,
+	}
+	if _, err := b.Api.Request(answer); err != nil {
+		b.logger.Error("Failed to answer inline query", "error", err)
+	}
+}
@@ -0,0 +1,310 @@
+package telegram
+
+import (
+	"currencyhub/internal/entities"
+	"currencyhub/internal/infrastructure/i18n"
+)
+
+// translator localizes bot replies, English is used for unsupported languages
+var translator = i18n.NewTranslator(messages, entities.DefaultLanguage)
+
+// messages is catalog of bot replies by language
+var messages = i18n.Catalog{
+	"en": {
+		"start": `🤖 💰 Welcome to Currency Hub Bot!
+
+📋 Available commands:
+/rates - show all rates 📊
+/rates [coin] - show coin rate (id, ticker or name) 📈
+/candles [coin] [interval] - candles for period 🕯
+/coins - list of available coins 🪙
+/quote [code] - currency prices are shown in 💱
+/watch [coins] - watchlist 👀
+/unwatch [coins] - remove from watchlist 🙈
+/alert [coin] > [price] - notify when price crosses threshold 🚨
+/alert [coin] 5%% [window] - notify about sharp price move ⚡️
+/alerts - list of alerts 📝
+/alert_del [id] - remove alert 🗑
+/buy [amount] [coin] [price] - record purchase 🟢
+/sell [amount] [coin] [price] - record sale 🔴
+/portfolio - portfolio and profit 💼
+/token - portfolio API token 🔑
+/start_auto [min] - enable auto-updates 🔔
+/stop_auto - disable auto-updates 🔕
+/lang [code] - interface language 🌐
+/help - show help ❓`,
+		"help": `🤖 💰 Available commands:
+
+📊 /rates - show all rates
+📈 /rates [coin] - show coin rate (bitcoin, BTC, Bitcoin)
+🕯 /candles [coin] [interval] - candles (1m, 5m, 1h, 1d), pick with buttons when called without arguments
+🪙 /coins - list of available coins with rate buttons
+💱 /quote [code] - currency prices are shown in (usd, eur, rub...)
+👀 /watch [coins] - show only these coins in /rates and auto-updates (bitcoin solana)
+🙈 /unwatch [coins] - remove coins from watchlist, clear it without arguments
+🚨 /alert [coin] > [price] - notify when price rises above (>) or falls below (<) threshold
+⚡️ /alert [coin] 5%% [window] [cooldown] - notify when price moves by 5%% within window (30m, 1h, 1d; +5%% - rise, -5%% - drop)
+📝 /alerts - list of active alerts
+🗑 /alert_del [id] - remove alert
+🟢 /buy [amount] [coin] [price] - record purchase (/buy 0.5 bitcoin 62000, current rate without price)
+🔴 /sell [amount] [coin] [price] - record sale
+💼 /portfolio - portfolio value, realized and unrealized profit
+🔑 /token - issue token for GET /api/v1/portfolio
+🔔 /start_auto [min] - enable auto-updates
+🔕 /stop_auto - disable auto-updates
+🌐 /lang [en|ru|auto] - interface language
+❓ /help - show help`,
+		"unknown_command": "❌ Unknown command. Choose one of the commands below.",
+		"error.fetch":     "❌ Failed to get data, try again later",
+		"error.settings":  "❌ Failed to save setting",
+
+		"rates.error":     "❌ Failed to get rates",
+		"rates.title":     "📊 Current rates:\n\n",
+		"rates.watchlist": "👀 Watchlist rates:\n\n",
+		"rate.line":       "💰 %s: %s %s 1h %s · 24h %s%s\n",
+		"rate.missing":    "📭 %s prices in %s are not collected yet, try again later",
+		"rate.card":       "💰 %s rate:\n📊 Current: %s\n📉 Daily low: %s\n📈 Daily high: %s\n%s 1 hour change: %s\n%s 24 hours: %s\n%s 7 days: %s",
+		"rate.stale":      "\n⚠️ Price is stale: sources returned no data",
+		"update.title":    "🔔 Rates auto-update:\n\n",
+		"inline.changes":  "%s 1h %s · 24h %s · 7d %s",
+
+		"candles.pick":         "🕯 Choose coin:",
+		"candles.interval":     "🕯 Choose %s candle interval:",
+		"candles.bad_interval": "❌ Invalid interval. Available: 1m, 5m, 1h, 1d",
+		"candles.empty":        "ℹ️ No %s data for period (%s)",
+		"candles.title":        "🕯 %s candles (%s), UTC:\n\n",
+
+		"coins.error":       "❌ Failed to get coin list",
+		"coins.title":       "📋 Available coins:\n",
+		"coins.pick":        "\n👇 Tap to see rate",
+		"coin.not_found":    "❌ Coin not found",
+		"coin.did_you_mean": "\n🤔 Did you mean: %s",
+
+		"quote.current": "💱 Prices are shown in %s\nAvailable: %s\nChange: /quote eur",
+		"quote.unknown": "❌ Unknown currency. Available: %s",
+		"quote.changed": "💱 Prices are now shown in %s",
+
+		"lang.current": "🌐 Language: %s\nAvailable: %s\nChange: /lang ru, follow Telegram language: /lang auto",
+		"lang.unknown": "❌ Unknown language. Available: %s",
+		"lang.changed": "🌐 Language: English",
+		"lang.auto":    "🌐 Language will follow your Telegram settings",
+		"lang.name":    "English",
+
+		"watchlist.save_error": "❌ Failed to save watchlist",
+		"watchlist.error":      "❌ Failed to get watchlist",
+		"watchlist.empty":      "👀 Watchlist is empty, /rates and auto-updates show all coins\nAdd: /watch bitcoin solana",
+		"watchlist.list":       "👀 Watchlist: %s\nRemove: /unwatch [coin], clear: /unwatch",
+
+		"auto.bad_interval":  "❌ Invalid interval. Use number greater than 0",
+		"auto.enable_error":  "❌ Failed to enable auto-updates",
+		"auto.enabled|one":   "🔔 Auto-updates enabled: every %d minute",
+		"auto.enabled|other": "🔔 Auto-updates enabled: every %d minutes",
+		"auto.confirm":       "🔕 Disable auto-updates?",
+		"auto.disable_error": "❌ Failed to disable auto-updates",
+		"auto.disabled":      "🔕 Auto-updates disabled",
+		"auto.kept":          "🔔 Auto-updates stay enabled",
+		"button.refresh":     "🔄 Refresh",
+		"button.candles":     "🕯 Candles",
+		"button.stop_auto":   "🔕 Yes, disable",
+		"button.cancel":      "Cancel",
+		"callback.refreshed": "🔄 Refreshed",
+		"callback.expired":   "❌ Button is outdated",
+
+		"alert.usage": "❌ Specify coin, sign and price: /alert bitcoin > 70000 or /alert ethereum < 2500\n" +
+			"Or change within window: /alert solana 5%% 1h (+5%% - rise only, -5%% - drop only, fourth argument - pause between notifications)",
+		"alert.created":        "🚨 Alert #%d created: %s",
+		"alert.cooldown":       " (at most once per %s)",
+		"alert.condition_met":  "ℹ️ %s price is already %s",
+		"alert.too_many|one":   "❌ You can create at most %d alert, remove extra ones: /alerts",
+		"alert.too_many|other": "❌ You can create at most %d alerts, remove extra ones: /alerts",
+		"alert.bad_window":     "❌ Window and pause must be from %s to %s",
+		"alert.bad_threshold":  "❌ Threshold must be greater than zero",
+		"alert.create_error":   "❌ Failed to create alert",
+		"alert.above":          "above %s",
+		"alert.below":          "below %s",
+		"alert.move":           "%s %s%s%% in %s",
+		"alert.triggered":      "🚨 Alert #%d: %s price is %s\n💰 Current price: %s",
+		"alert.rose":           "🚨 Alert #%d: %s price rose by %s%% in %s 📈\n💰 Current price: %s\n🕰 Price at %s: %s",
+		"alert.fell":           "🚨 Alert #%d: %s price fell by %s%% in %s 📉\n💰 Current price: %s\n🕰 Price at %s: %s",
+		"alerts.error":         "❌ Failed to get alerts",
+		"alerts.empty":         "📭 No active alerts. Create one: /alert bitcoin > 70000",
+		"alerts.title|one":     "📝 %d active alert:\n\n",
+		"alerts.title|other":   "📝 %d active alerts:\n\n",
+		"alerts.delete_hint":   "\nRemove: /alert_del [id]",
+		"alert.delete_usage":   "❌ Specify alert number: /alert_del 5",
+		"alert.not_found":      "❌ Alert not found",
+		"alert.delete_error":   "❌ Failed to remove alert",
+		"alert.deleted":        "🗑 Alert #%d removed",
+
+		"trade.usage":        "❌ Specify amount, coin and price: /%s 0.5 bitcoin 62000\nCurrent rate is used without price",
+		"trade.bought":       "🟢 Purchase recorded: %s %s at %s\nPortfolio: /portfolio",
+		"trade.sold":         "🔴 Sale recorded: %s %s at %s\nPortfolio: /portfolio",
+		"trade.insufficient": "❌ Cannot sell more than portfolio holds (%s)",
+		"trade.no_price":     "📭 %s prices are not collected yet, specify trade price",
+		"trade.invalid":      "❌ Amount and price must be greater than zero",
+		"trade.error":        "❌ Failed to save trade",
+
+		"portfolio.error":    "❌ Failed to get portfolio",
+		"portfolio.empty":    "📭 Portfolio is empty. Record purchase: /buy 0.5 bitcoin 62000",
+		"portfolio.title":    "💼 Portfolio:\n\n",
+		"portfolio.closed":   "📦 %s: position closed, realized %s\n\n",
+		"portfolio.position": "📦 %s: %s at average %s\n",
+		"portfolio.value":    "💰 Value: %s (invested %s)\n%s Unrealized: %s\n",
+		"portfolio.unpriced": "⚠️ Price not collected, invested %s\n",
+		"portfolio.realized": "🧾 Realized: %s\n",
+		"portfolio.total":    "📊 Total in %s: %s (invested %s)\n%s Unrealized: %s · realized: %s\n",
+
+		"token.error":  "❌ Failed to issue token",
+		"token.issued": "🔑 Portfolio API token (shown once, previous token no longer works):\n%s\n\nRequest: GET /api/v1/portfolio with header Authorization: Bearer <token>",
+	},
+	"ru": {
+		"start": `🤖 💰 Добро пожаловать в Currency Hub Bot!
+
+📋 Доступные команды:
+/rates - показать все курсы валют 📊
+/rates [валюта] - показать курс валюты (id, тикер или название) 📈
+/candles [валюта] [интервал] - свечи за период 🕯
+/coins - список всех доступных валют 🪙
+/quote [код] - валюта отображения цен 💱
+/watch [валюты] - список наблюдения 👀
+/unwatch [валюты] - убрать из списка наблюдения 🙈
+/alert [валюта] > [цена] - уведомить о пересечении цены 🚨
+/alert [валюта] 5%% [период] - уведомить о резком движении цены ⚡️
+/alerts - список алертов 📝
+/alert_del [id] - удалить алерт 🗑
+/buy [кол-во] [валюта] [цена] - записать покупку 🟢
+/sell [кол-во] [валюта] [цена] - записать продажу 🔴
+/portfolio - портфель и прибыль 💼
+/token - токен API портфеля 🔑
+/start_auto [мин] - запустить автоподписку 🔔
+/stop_auto - остановить автоподписку 🔕
+/lang [код] - язык интерфейса 🌐
+/help - показать справку ❓`,
+		"help": `🤖 💰 Доступные команды:
+
+📊 /rates - показать все курсы валют
+📈 /rates [валюта] - показать курс валюты (bitcoin, BTC, Bitcoin)
+🕯 /candles [валюта] [интервал] - свечи (1m, 5m, 1h, 1d), без аргументов - выбор кнопками
+🪙 /coins - список всех доступных валют с кнопками курсов
+💱 /quote [код] - валюта отображения цен (usd, eur, rub...)
+👀 /watch [валюты] - показывать в /rates и автообновлениях только эти валюты (bitcoin solana)
+🙈 /unwatch [валюты] - убрать валюты из списка, без аргументов - очистить
+🚨 /alert [валюта] > [цена] - уведомить, когда цена станет выше (>) или ниже (<) порога
+⚡️ /alert [валюта] 5%% [период] [пауза] - уведомить, когда цена изменится на 5%% за период (30m, 1h, 1d; +5%% - рост, -5%% - падение)
+📝 /alerts - список активных алертов
+🗑 /alert_del [id] - удалить алерт
+🟢 /buy [кол-во] [валюта] [цена] - записать покупку (/buy 0.5 bitcoin 62000, без цены - по текущему курсу)
+🔴 /sell [кол-во] [валюта] [цена] - записать продажу
+💼 /portfolio - стоимость портфеля, реализованная и нереализованная прибыль
+🔑 /token - выпустить токен для GET /api/v1/portfolio
+🔔 /start_auto [мин] - запустить автоподписку
+🔕 /stop_auto - остановить автоподписку
+🌐 /lang [en|ru|auto] - язык интерфейса
+❓ /help - показать справку`,
+		"unknown_command": "❌ Неизвестная команда. Выберите команду из доступных ниже.",
+		"error.fetch":     "❌ Ошибка при получении, попробуйте позже",
+		"error.settings":  "❌ Ошибка сохранения настройки",
+
+		"rates.error":     "❌ Ошибка получения курсов",
+		"rates.title":     "📊 Текущие курсы:\n\n",
+		"rates.watchlist": "👀 Курсы из списка наблюдения:\n\n",
+		"rate.line":       "💰 %s: %s %s 1ч %s · 24ч %s%s\n",
+		"rate.missing":    "📭 Цены %s в %s еще не собраны, попробуйте позже",
+		"rate.card":       "💰 Курс %s:\n📊 Текущий: %s\n📉 Мин. за день: %s\n📈 Макс. за день: %s\n%s Изменение за час: %s\n%s За 24 часа: %s\n%s За 7 дней: %s",
+		"rate.stale":      "\n⚠️ Цена устарела: источники не вернули данные",
+		"update.title":    "🔔 Автообновление курсов:\n\n",
+		"inline.changes":  "%s 1ч %s · 24ч %s · 7д %s",
+
+		"candles.pick":         "🕯 Выберите валюту:",
+		"candles.interval":     "🕯 Выберите интервал свечей %s:",
+		"candles.bad_interval": "❌ Неверный интервал. Доступны: 1m, 5m, 1h, 1d",
+		"candles.empty":        "ℹ️ Нет данных %s за период (%s)",
+		"candles.title":        "🕯 Свечи %s (%s), UTC:\n\n",
+
+		"coins.error":       "❌ Ошибка получения списка валют",
+		"coins.title":       "📋 Доступные валюты:\n",
+		"coins.pick":        "\n👇 Нажмите, чтобы посмотреть курс",
+		"coin.not_found":    "❌ Валюта не найдена",
+		"coin.did_you_mean": "\n🤔 Возможно, вы имели в виду: %s",
+
+		"quote.current": "💱 Цены отображаются в %s\nДоступны: %s\nИзменить: /quote eur",
+		"quote.unknown": "❌ Неизвестная валюта. Доступны: %s",
+		"quote.changed": "💱 Теперь цены отображаются в %s",
+
+		"lang.current": "🌐 Язык: %s\nДоступны: %s\nИзменить: /lang en, по языку Telegram: /lang auto",
+		"lang.unknown": "❌ Неизвестный язык. Доступны: %s",
+		"lang.changed": "🌐 Язык: русский",
+		"lang.auto":    "🌐 Язык будет определяться по настройкам Telegram",
+		"lang.name":    "Русский",
+
+		"watchlist.save_error": "❌ Ошибка сохранения списка наблюдения",
+		"watchlist.error":      "❌ Ошибка получения списка наблюдения",
+		"watchlist.empty":      "👀 Список наблюдения пуст, /rates и автообновления показывают все валюты\nДобавить: /watch bitcoin solana",
+		"watchlist.list":       "👀 Список наблюдения: %s\nУбрать: /unwatch [валюта], очистить: /unwatch",
+
+		"auto.bad_interval":  "❌ Неверный интервал. Используйте число больше 0",
+		"auto.enable_error":  "❌ Ошибка включения автоподписки",
+		"auto.enabled|one":   "🔔 Автоподписка включена: обновления каждую %d минуту",
+		"auto.enabled|few":   "🔔 Автоподписка включена: обновления каждые %d минуты",
+		"auto.enabled|many":  "🔔 Автоподписка включена: обновления каждые %d минут",
+		"auto.confirm":       "🔕 Отключить автоподписку?",
+		"auto.disable_error": "❌ Ошибка отключения автоподписки",
+		"auto.disabled":      "🔕 Автоподписка отключена",
+		"auto.kept":          "🔔 Автоподписка остается включенной",
+		"button.refresh":     "🔄 Обновить",
+		"button.candles":     "🕯 Свечи",
+		"button.stop_auto":   "🔕 Да, отключить",
+		"button.cancel":      "Отмена",
+		"callback.refreshed": "🔄 Обновлено",
+		"callback.expired":   "❌ Кнопка устарела",
+
+		"alert.usage": "❌ Укажите валюту, знак и цену: /alert bitcoin > 70000 или /alert ethereum < 2500\n" +
+			"Или изменение за период: /alert solana 5%% 1h (+5%% - только рост, -5%% - только падение, четвертым аргументом - пауза между уведомлениями)",
+		"alert.created":       "🚨 Алерт #%d создан: %s",
+		"alert.cooldown":      " (не чаще раза в %s)",
+		"alert.condition_met": "ℹ️ Цена %s уже %s",
+		"alert.too_many|one":  "❌ Можно создать не больше %d алерта, удалите лишние: /alerts",
+		"alert.too_many|few":  "❌ Можно создать не больше %d алертов, удалите лишние: /alerts",
+		"alert.too_many|many": "❌ Можно создать не больше %d алертов, удалите лишние: /alerts",
+		"alert.bad_window":    "❌ Период и пауза должны быть от %s до %s",
+		"alert.bad_threshold": "❌ Порог должен быть больше нуля",
+		"alert.create_error":  "❌ Ошибка создания алерта",
+		"alert.above":         "выше %s",
+		"alert.below":         "ниже %s",
+		"alert.move":          "%s %s%s%% за %s",
+		"alert.triggered":     "🚨 Алерт #%d: цена %s %s\n💰 Текущая цена: %s",
+		"alert.rose":          "🚨 Алерт #%d: цена %s выросла на %s%% за %s 📈\n💰 Текущая цена: %s\n🕰 Цена %s: %s",
+		"alert.fell":          "🚨 Алерт #%d: цена %s упала на %s%% за %s 📉\n💰 Текущая цена: %s\n🕰 Цена %s: %s",
+		"alerts.error":        "❌ Ошибка получения алертов",
+		"alerts.empty":        "📭 Активных алертов нет. Создать: /alert bitcoin > 70000",
+		"alerts.title|one":    "📝 %d активный алерт:\n\n",
+		"alerts.title|few":    "📝 %d активных алерта:\n\n",
+		"alerts.title|many":   "📝 %d активных алертов:\n\n",
+		"alerts.delete_hint":  "\nУдалить: /alert_del [id]",
+		"alert.delete_usage":  "❌ Укажите номер алерта: /alert_del 5",
+		"alert.not_found":     "❌ Алерт не найден",
+		"alert.delete_error":  "❌ Ошибка удаления алерта",
+		"alert.deleted":       "🗑 Алерт #%d удален",
+
+		"trade.usage":        "❌ Укажите количество, валюту и цену: /%s 0.5 bitcoin 62000\nБез цены используется текущий курс",
+		"trade.bought":       "🟢 Покупка записана: %s %s по %s\nПортфель: /portfolio",
+		"trade.sold":         "🔴 Продажа записана: %s %s по %s\nПортфель: /portfolio",
+		"trade.insufficient": "❌ Нельзя продать больше, чем есть в портфеле (%s)",
+		"trade.no_price":     "📭 Цены %s еще не собраны, укажите цену сделки",
+		"trade.invalid":      "❌ Количество и цена должны быть больше нуля",
+		"trade.error":        "❌ Ошибка сохранения сделки",
+
+		"portfolio.error":    "❌ Ошибка получения портфеля",
+		"portfolio.empty":    "📭 Портфель пуст. Записать покупку: /buy 0.5 bitcoin 62000",
+		"portfolio.title":    "💼 Портфель:\n\n",
+		"portfolio.closed":   "📦 %s: позиция закрыта, реализовано %s\n\n",
+		"portfolio.position": "📦 %s: %s по средней %s\n",
+		"portfolio.value":    "💰 Стоимость: %s (вложено %s)\n%s Нереализовано: %s\n",
+		"portfolio.unpriced": "⚠️ Цена не собрана, вложено %s\n",
+		"portfolio.realized": "🧾 Реализовано: %s\n",
+		"portfolio.total":    "📊 Итого в %s: %s (вложено %s)\n%s Нереализовано: %s · реализовано: %s\n",
+
+		"token.error":  "❌ Ошибка выпуска токена",
+		"token.issued": "🔑 Токен API портфеля (показывается один раз, предыдущий токен больше не действует):\n%s\n\nЗапрос: GET /api/v1/portfolio с заголовком Authorization: Bearer <токен>",
+	},
+}
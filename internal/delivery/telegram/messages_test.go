@@ -0,0 +1,56 @@
+package telegram
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// verbPattern matches fmt verbs of catalog message, escaped percent sign included
+var verbPattern = regexp.MustCompile(`%[-+# 0-9.]*[a-zA-Z%]`)
+
+// messageVerbs returns fmt verbs of message without escaped percent signs
+func messageVerbs(format string) []string {
+	var verbs []string
+	for _, verb := range verbPattern.FindAllString(format, -1) {
+		if verb != "%%" {
+			verbs = append(verbs, verb)
+		}
+	}
+	return verbs
+}
+
+func TestMessages_Render(t *testing.T) {
+	for language, catalog := range messages {
+		for key, format := range catalog {
+			var args []any
+			for _, verb := range messageVerbs(format) {
+				if strings.HasSuffix(verb, "d") {
+					args = append(args, 1)
+				} else {
+					args = append(args, "x")
+				}
+			}
+
+			rendered := fmt.Sprintf(format, args...)
+			assert.NotContains(t, rendered, "%!", "%s %s", language, key)
+		}
+	}
+}
+
+func TestMessages_SameVerbs(t *testing.T) {
+	verbs := make(map[string][]string)
+	for language, catalog := range messages {
+		for key, format := range catalog {
+			base, _, _ := strings.Cut(key, "|")
+			got := messageVerbs(format)
+			if want, ok := verbs[base]; ok {
+				assert.Equal(t, want, got, "%s %s", language, key)
+				continue
+			}
+			verbs[base] = got
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package telegram
+
+import (
+	"context"
+	"currencyhub/internal/entities"
+	"currencyhub/internal/infrastructure/i18n"
+	"errors"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"math"
+	"regexp"
+	"strings"
+)
+
+// numberLike matches argument written as number, whether or not its separators are valid
+var numberLike = regexp.MustCompile(`^[0-9][0-9.,]*$`)
+
+// handleBuy processes /buy command - records coins bought
+func (b *Bot) handleBuy(ctx context.Context, message *tgbotapi.Message) {
+	b.handleTrade(ctx, message, entities.TradeBuy)
+}
+
+// handleSell processes /sell command - records coins sold
+func (b *Bot) handleSell(ctx context.Context, message *tgbotapi.Message) {
+	b.handleTrade(ctx, message, entities.TradeSell)
+}
+
+// handleTrade records trade of given side from command arguments
+// Price defaults to latest saved price when omitted
+func (b *Bot) handleTrade(ctx context.Context, message *tgbotapi.Message, side entities.TradeSide) {
+	loc := b.localizer(ctx, message.Chat.ID, message.From)
+	query, amount, price, ok := parseTrade(loc, message.CommandArguments())
+	if !ok {
+		b.sendMessage(message.Chat.ID, loc.T("trade.usage", side))
+		return
+	}
+
+	coin, ok := b.resolveCoin(ctx, loc, message.Chat.ID, query)
+	if !ok {
+		return
+	}
+	transaction := &entities.Transaction{
+		UserID:     message.Chat.ID,
+		CurrencyID: coin.ID,
+		Quote:      b.userQuote(ctx, message.Chat.ID),
+		Side:       side,
+		Amount:     amount,
+		Price:      price,
+	}
+
+	err := b.portfolioUseCase.RecordTrade(ctx, transaction)
+	switch {
+	case err == nil:
+		key := "trade.bought"
+		if side == entities.TradeSell {
+			key = "trade.sold"
+		}
+		b.sendMessage(message.Chat.ID, loc.T(key, loc.Amount(transaction.Amount), coin.ID,
+			loc.Price(transaction.Price, transaction.Quote)))
+	case errors.Is(err, entities.ErrInsufficientHoldings):
+		b.sendMessage(message.Chat.ID, loc.T("trade.insufficient", coin.ID))
+	case errors.Is(err, entities.ErrCurrencyNotFound):
+		b.sendMessage(message.Chat.ID, loc.T("trade.no_price", coin.Symbol))
+	case errors.Is(err, entities.ErrInvalidArgument):
+		b.sendMessage(message.Chat.ID, loc.T("trade.invalid"))
+	default:
+		b.logger.Error("Failed to record trade", "currency", coin.ID, "side", side, "error", err)
+		b.sendMessage(message.Chat.ID, loc.T("trade.error"))
+	}
+}
+
+// parseTrade reads coin query, amount and optional price from trade arguments
+// Numbers are read with separators of user language, zero price is returned when price is omitted
+func parseTrade(loc i18n.Localizer, args string) (string, float64, float64, bool) {
+	fields := strings.Fields(args)
+	if len(fields) < 2 {
+		return "", 0, 0, false
+	}
+	amount, err := loc.ParseNumber(fields[0])
+	if err != nil {
+		return "", 0, 0, false
+	}
+
+	fields = fields[1:]
+	price := 0.0
+	// Ambiguous price is rejected rather than taken as part of coin name
+	if last := fields[len(fields)-1]; len(fields) > 1 && numberLike.MatchString(last) {
+		if price, err = loc.ParseNumber(last); err != nil {
+			return "", 0, 0, false
+		}
+		fields = fields[:len(fields)-1]
+	}
+	return strings.Join(fields, " "), amount, price, true
+}
+
+// handlePortfolio processes /portfolio command - shows holdings with profit and loss
+func (b *Bot) handlePortfolio(ctx context.Context, message *tgbotapi.Message) {
+	loc := b.localizer(ctx, message.Chat.ID, message.From)
+	portfolio, err := b.portfolioUseCase.GetPortfolio(ctx, message.Chat.ID)
+	if err != nil {
+		b.logger.Error("Failed to get portfolio", "error", err)
+		b.sendMessage(message.Chat.ID, loc.T("portfolio.error"))
+		return
+	}
+	if len(portfolio.Positions) == 0 {
+		b.sendMessage(message.Chat.ID, loc.T("portfolio.empty"))
+		return
+	}
+	b.sendMessage(message.Chat.ID, formatPortfolio(loc, portfolio))
+}
+
+// formatPortfolio builds portfolio message with positions and totals
+func formatPortfolio(loc i18n.Localizer, portfolio *entities.Portfolio) string {
+	var msg strings.Builder
+	msg.WriteString(loc.T("portfolio.title"))
+	for _, position := range portfolio.Positions {
+		quote := position.Quote
+		if position.Amount == 0 {
+			msg.WriteString(loc.T("portfolio.closed", position.CurrencyID, formatPnL(loc, position.RealizedPnL, quote)))
+			continue
+		}
+
+		msg.WriteString(loc.T("portfolio.position", position.CurrencyID, loc.Amount(position.Amount),
+			loc.Price(position.AveragePrice, quote)))
+		if position.Priced {
+			msg.WriteString(loc.T("portfolio.value", loc.Price(position.Value, quote), loc.Price(position.CostBasis, quote),
+				trendEmoji(position.UnrealizedPnL), formatPnL(loc, position.UnrealizedPnL, quote)))
+		} else {
+			msg.WriteString(loc.T("portfolio.unpriced", loc.Price(position.CostBasis, quote)))
+		}
+		if position.RealizedPnL != 0 {
+			msg.WriteString(loc.T("portfolio.realized", formatPnL(loc, position.RealizedPnL, quote)))
+		}
+		msg.WriteString("\n")
+	}
+
+	for _, total := range portfolio.Totals {
+		quote := total.Quote
+		msg.WriteString(loc.T("portfolio.total", strings.ToUpper(quote), loc.Price(total.Value, quote),
+			loc.Price(total.CostBasis, quote), trendEmoji(total.UnrealizedPnL),
+			formatPnL(loc, total.UnrealizedPnL, quote), formatPnL(loc, total.RealizedPnL, quote)))
+	}
+	return msg.String()
+}
+
+// formatPnL formats signed profit or loss in quote currency
+func formatPnL(loc i18n.Localizer, value float64, quote string) string {
+	sign := "+"
+	if value < 0 {
+		sign = "-"
+	}
+	return sign + loc.Price(math.Abs(value), quote)
+}
+
+// handleToken processes /token command - issues portfolio API token
+// Token is shown only once, previous token stops working
+func (b *Bot) handleToken(ctx context.Context, message *tgbotapi.Message) {
+	loc := b.localizer(ctx, message.Chat.ID, message.From)
+	token, err := b.portfolioUseCase.IssueToken(ctx, message.Chat.ID)
+	if err != nil {
+		b.logger.Error("Failed to issue api token", "error", err)
+		b.sendMessage(message.Chat.ID, loc.T("token.error"))
+		return
+	}
+
+	b.sendMessage(message.Chat.ID, loc.T("token.issued", token))
+}
@@ -0,0 +1,42 @@
+package telegram
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseTrade(t *testing.T) {
+	en, ru := translator.Localizer("en"), translator.Localizer("ru")
+
+	tests := []struct {
+		name   string
+		args   string
+		query  string
+		amount float64
+		price  float64
+		valid  bool
+	}{
+		{"grouped price", "1 bitcoin 62,000", "bitcoin", 1, 62000, true},
+		{"decimal price", "0.5 bitcoin 62,000.50", "bitcoin", 0.5, 62000.5, true},
+		{"without price", "2 bitcoin cash", "bitcoin cash", 2, 0, true},
+		{"ambiguous price", "1 bitcoin 62,0", "", 0, 0, false},
+		{"ambiguous amount", "1,5 bitcoin", "", 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, amount, price, ok := parseTrade(en, tt.args)
+
+			assert.Equal(t, tt.valid, ok)
+			assert.Equal(t, tt.query, query)
+			assert.Equal(t, tt.amount, amount)
+			assert.Equal(t, tt.price, price)
+		})
+	}
+
+	query, amount, price, ok := parseTrade(ru, "1,5 bitcoin 62000,5")
+	assert.True(t, ok)
+	assert.Equal(t, "bitcoin", query)
+	assert.Equal(t, 1.5, amount)
+	assert.Equal(t, 62000.5, price)
+}
@@ -0,0 +1,90 @@
+package entities
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// Alert errors reported to users creating or deleting alerts
+var (
+	ErrAlertNotFound     = errors.New("alert not found")                // User has no alert with requested identifier
+	ErrTooManyAlerts     = errors.New("too many alerts")                // User reached limit of active alerts
+	ErrAlertConditionMet = errors.New("alert condition is already met") // Current price is already beyond threshold
+)
+
+// AlertCondition tells on which side of threshold alert fires
+// Move conditions compare percent change over alert window instead of price
+type AlertCondition string
+
+// Supported alert conditions
+const (
+	AlertAbove AlertCondition = "above" // Price rises above threshold
+	AlertBelow AlertCondition = "below" // Price falls below threshold
+	AlertRise  AlertCondition = "rise"  // Price grows by threshold percent within window
+	AlertFall  AlertCondition = "fall"  // Price drops by threshold percent within window
+	AlertMove  AlertCondition = "move"  // Price changes by threshold percent in either direction within window
+)
+
+// IsValid reports whether condition is supported
+func (c AlertCondition) IsValid() bool {
+	return c == AlertAbove || c == AlertBelow || c.IsMove()
+}
+
+// IsMove reports whether condition tracks percent change over window
+func (c AlertCondition) IsMove() bool {
+	return c == AlertRise || c == AlertFall || c == AlertMove
+}
+
+// Met reports whether value satisfies condition against threshold
+// Value is price for threshold conditions and signed percent change for move conditions
+func (c AlertCondition) Met(value, threshold float64) bool {
+	switch c {
+	case AlertAbove:
+		return value > threshold
+	case AlertBelow:
+		return value < threshold
+	case AlertRise:
+		return value >= threshold
+	case AlertFall:
+		return value <= -threshold
+	case AlertMove:
+		return math.Abs(value) >= threshold
+	}
+	return false
+}
+
+// Alert represents user rule notifying about coin price crossing threshold or moving by percent
+// Threshold alert fires once and gets TriggeredAt, move alert repeats after cooldown
+type Alert struct {
+	ID              int64          `db:"id"`               // Sequential alert identifier
+	UserID          int64          `db:"telegram_id"`      // Telegram user owning alert
+	CurrencyID      string         `db:"currency_id"`      // Watched cryptocurrency identifier
+	Quote           string         `db:"quote"`            // Quote currency of threshold
+	Condition       AlertCondition `db:"condition"`        // Side of threshold or direction of move alert fires on
+	Threshold       float64        `db:"threshold"`        // Price level in quote currency or percent change for move alerts
+	WindowSeconds   int64          `db:"window_seconds"`   // Period price change is measured over by move alerts
+	CooldownSeconds int64          `db:"cooldown_seconds"` // Pause after move alert fires before it may fire again
+	CreatedAt       time.Time      `db:"created_at"`       // Moment alert was created
+	TriggeredAt     *time.Time     `db:"triggered_at"`     // Moment threshold alert fired, nil while active
+	LastFiredAt     *time.Time     `db:"last_fired_at"`    // Moment move alert fired last time
+}
+
+// Window returns period price change is measured over
+func (a *Alert) Window() time.Duration {
+	return time.Duration(a.WindowSeconds) * time.Second
+}
+
+// Cooldown returns pause move alert keeps after firing
+func (a *Alert) Cooldown() time.Duration {
+	return time.Duration(a.CooldownSeconds) * time.Second
+}
+
+// AlertTriggered is published when saved price fires user alert
+// Base and Change are set for move alerts only
+type AlertTriggered struct {
+	Alert  *Alert     // Fired alert
+	Tick   *PriceTick // Price observation that fired it
+	Base   *PriceTick // Observation at window start change is measured from
+	Change float64    // Signed percent change over window
+}
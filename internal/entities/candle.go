@@ -0,0 +1,53 @@
+package entities
+
+import "time"
+
+// CandleInterval identifies resolution of OHLC candle aggregation
+type CandleInterval string
+
+// Supported candle resolutions
+const (
+	Interval1m CandleInterval = "1m"
+	Interval5m CandleInterval = "5m"
+	Interval1h CandleInterval = "1h"
+	Interval1d CandleInterval = "1d"
+)
+
+// CandleIntervals contains resolutions maintained as rollups
+// Every saved price updates one candle per resolution
+var CandleIntervals = []CandleInterval{Interval1m, Interval5m, Interval1h, Interval1d}
+
+// Duration returns bucket length of candle interval
+// Returns zero for unsupported intervals
+func (i CandleInterval) Duration() time.Duration {
+	switch i {
+	case Interval1m:
+		return time.Minute
+	case Interval5m:
+		return 5 * time.Minute
+	case Interval1h:
+		return time.Hour
+	case Interval1d:
+		return 24 * time.Hour
+	}
+	return 0
+}
+
+// IsValid checks that interval is one of supported resolutions
+func (i CandleInterval) IsValid() bool {
+	return i.Duration() > 0
+}
+
+// Candle represents open/high/low/close price aggregate for time bucket
+// Ticks holds number of observations since sources provide no traded volume
+type Candle struct {
+	CurrencyID string         `db:"currency_id"` // Unique cryptocurrency identifier
+	Quote      string         `db:"quote"`       // Quote currency code of prices
+	Interval   CandleInterval `db:"resolution"`  // Candle resolution
+	OpenTime   time.Time      `db:"open_time"`   // Bucket start time
+	Open       float64        `db:"open_price"`  // First observed price in bucket
+	High       float64        `db:"high_price"`  // Highest observed price in bucket
+	Low        float64        `db:"low_price"`   // Lowest observed price in bucket
+	Close      float64        `db:"close_price"` // Last observed price in bucket
+	Ticks      int64          `db:"ticks"`       // Number of observations in bucket
+}
@@ -0,0 +1,41 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrCoinNotFound is returned when coin is missing from catalog
+var ErrCoinNotFound = errors.New("coin not found")
+
+// Coin represents cryptocurrency in supported coin catalog
+// Disabled coins are neither fetched nor accepted in requests
+type Coin struct {
+	ID      string    `db:"id"`       // Unique cryptocurrency identifier, CoinGecko slug
+	Symbol  string    `db:"symbol"`   // Ticker symbol, e.g. BTC
+	Name    string    `db:"name"`     // Human readable name
+	Enabled bool      `db:"enabled"`  // Whether coin is tracked
+	AddedAt time.Time `db:"added_at"` // Moment coin was added to catalog
+}
+
+// CoinNotFoundError reports query that matched no tracked coin
+// Suggestions holds identifiers of similar coins, closest first
+type CoinNotFoundError struct {
+	Query       string
+	Suggestions []string
+}
+
+// Error implements error interface
+func (e *CoinNotFoundError) Error() string {
+	if len(e.Suggestions) == 0 {
+		return fmt.Sprintf("coin not found: %s", e.Query)
+	}
+	return fmt.Sprintf("coin not found: %s, did you mean: %s", e.Query, strings.Join(e.Suggestions, ", "))
+}
+
+// Unwrap allows matching with ErrCoinNotFound
+func (e *CoinNotFoundError) Unwrap() error {
+	return ErrCoinNotFound
+}
@@ -15,13 +15,44 @@ import "time"
 // CurrencyRate represents cryptocurrency rate information
 // Stores current and historical price data with statistics
 type CurrencyRate struct {
-	CurrencyID    string    `db:"currency_id"`    // Unique cryptocurrency identifier
-	CurrentPrice  float64   `db:"current_price"`  // Current market price in USD
-	MinPrice      float64   `db:"min_price"`      // Daily minimum price
-	MaxPrice      float64   `db:"max_price"`      // Daily maximum price
-	ChangePercent float64   `db:"change_percent"` // Hourly price change percentage
-	HourMinPrice  float64   `db:"hour_min_price"` // Hourly minimum price
-	HourMaxPrice  float64   `db:"hour_max_price"` // Hourly maximum price
-	TimeStamp     time.Time `db:"time_stamp"`     // Last update timestamp
-	Date          time.Time `db:"date"`           // Date for daily statistics
+	CurrencyID   string    `db:"currency_id"`    // Unique cryptocurrency identifier
+	Quote        string    `db:"quote"`          // Quote currency code prices are expressed in
+	CurrentPrice float64   `db:"current_price"`  // Current market price in quote currency
+	MinPrice     float64   `db:"min_price"`      // Daily minimum price
+	MaxPrice     float64   `db:"max_price"`      // Daily maximum price
+	Change1h     float64   `db:"change_percent"` // Signed price change over last hour, percent; column keeps its original name
+	Change24h    float64   `db:"change_24h"`     // Signed price change over last 24 hours, percent
+	Change7d     float64   `db:"change_7d"`      // Signed price change over last 7 days, percent
+	HourMinPrice float64   `db:"hour_min_price"` // Hourly minimum price
+	HourMaxPrice float64   `db:"hour_max_price"` // Hourly maximum price
+	TimeStamp    time.Time `db:"time_stamp"`     // Last update timestamp
+	Date         time.Time `db:"date"`           // Date for daily statistics
+	Stale        bool      `db:"stale"`          // Whether last update cycle received no price
+}
+
+// ChangePercent returns signed change from base to current price in percent
+// Returns 0 when base price is unknown
+func ChangePercent(current, base float64) float64 {
+	if base == 0 {
+		return 0
+	}
+	return (current - base) / base * 100
+}
+
+// FilterRates returns rates of given coins, all rates when coin list is empty
+func FilterRates(rates []*CurrencyRate, coinIDs []string) []*CurrencyRate {
+	if len(coinIDs) == 0 {
+		return rates
+	}
+	wanted := make(map[string]bool, len(coinIDs))
+	for _, id := range coinIDs {
+		wanted[id] = true
+	}
+	filtered := make([]*CurrencyRate, 0, len(coinIDs))
+	for _, rate := range rates {
+		if wanted[rate.CurrencyID] {
+			filtered = append(filtered, rate)
+		}
+	}
+	return filtered
 }
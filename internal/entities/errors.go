@@ -0,0 +1,28 @@
+package entities
+
+import "errors"
+
+// Domain errors shared by repositories and use cases
+// Delivery layers map them to protocol specific responses
+var (
+	ErrCurrencyNotFound = errors.New("currency not found")         // No rate stored for currency in quote
+	ErrUnsupportedQuote = errors.New("unsupported quote currency") // Quote code missing from QuoteCurrencies or not fetched
+	ErrInvalidArgument  = errors.New("invalid argument")           // Request argument rejected by validation
+)
+
+// ValidationError reports argument rejected by business rules
+// Field names offending argument as seen by API clients
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+// Error implements error interface
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// Unwrap allows matching with ErrInvalidArgument
+func (e *ValidationError) Unwrap() error {
+	return ErrInvalidArgument
+}
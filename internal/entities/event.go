@@ -0,0 +1,22 @@
+package entities
+
+import "time"
+
+// PriceUpdated is published when fetcher saves new price of coin
+type PriceUpdated struct {
+	Tick *PriceTick // Saved price observation
+}
+
+// FetchFailed is published when price update cycle ends with error
+// Unavailable is set when no provider returned prices at all
+type FetchFailed struct {
+	Err         error         // Update cycle error
+	Unavailable bool          // Whether all providers failed
+	RetryIn     time.Duration // Delay before next attempt
+	At          time.Time     // Moment cycle failed
+}
+
+// CoinAdded is published when coin is added to catalog
+type CoinAdded struct {
+	Coin *Coin // Added catalog coin
+}
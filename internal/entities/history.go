@@ -0,0 +1,63 @@
+package entities
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HistoryPoint represents price observations of cryptocurrency within time bucket
+// Price is average of bucket observations, MinPrice and MaxPrice bound them
+type HistoryPoint struct {
+	Time     time.Time `db:"bucket"`    // Bucket start time
+	Price    float64   `db:"price"`     // Average observed price in quote currency
+	MinPrice float64   `db:"min_price"` // Lowest observed price in bucket
+	MaxPrice float64   `db:"max_price"` // Highest observed price in bucket
+	Ticks    int64     `db:"ticks"`     // Number of observations in bucket
+}
+
+// TickCursor identifies position in price history ordered by observation time
+// Pages continue strictly after observation cursor points at
+type TickCursor struct {
+	ObservedAt time.Time
+	ID         int64
+}
+
+// String encodes cursor as opaque URL safe token
+func (c TickCursor) String() string {
+	raw := strconv.FormatInt(c.ObservedAt.UnixNano(), 10) + "." + strconv.FormatInt(c.ID, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseTickCursor decodes cursor token produced by TickCursor.String
+// Returns ValidationError for malformed tokens
+func ParseTickCursor(token string) (TickCursor, error) {
+	invalid := &ValidationError{Field: "cursor", Message: fmt.Sprintf("invalid cursor: %q", token)}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return TickCursor{}, invalid
+	}
+	nanos, id, ok := strings.Cut(string(raw), ".")
+	if !ok {
+		return TickCursor{}, invalid
+	}
+	unixNano, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return TickCursor{}, invalid
+	}
+	tickID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return TickCursor{}, invalid
+	}
+	return TickCursor{ObservedAt: time.Unix(0, unixNano).UTC(), ID: tickID}, nil
+}
+
+// TickPage represents page of raw price observations
+// NextCursor is empty on last page
+type TickPage struct {
+	Ticks      []*PriceTick
+	NextCursor string
+}
@@ -0,0 +1,21 @@
+package entities
+
+import "strings"
+
+// DefaultLanguage is language used when user's language is unknown or unsupported
+const DefaultLanguage = "en"
+
+// Languages contains supported interface languages
+var Languages = []string{"en", "ru"}
+
+// LookupLanguage finds supported language by case-insensitive code
+// Region suffix is ignored, so "ru-RU" and "en_US" are accepted
+func LookupLanguage(code string) (string, bool) {
+	base, _, _ := strings.Cut(strings.ToLower(strings.ReplaceAll(code, "_", "-")), "-")
+	for _, language := range Languages {
+		if language == base {
+			return language, true
+		}
+	}
+	return "", false
+}
@@ -1,8 +0,0 @@
-package entities
-
-// CurrencyList contains supported cryptocurrency identifiers
-// Used for API requests and validation
-var CurrencyList = []string{"bitcoin", "ethereum", "tether", "binancecoin", "solana",
-	"usd-coin", "ripple", "the-open-network", "dogecoin", "cardano",
-	"shiba-inu", "avalanche-2", "polkadot", "tron", "chainlink",
-	"polygon-pos", "bitcoin-cash", "litecoin", "uniswap", "dai"}
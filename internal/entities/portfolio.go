@@ -0,0 +1,64 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+// Portfolio errors reported to users recording trades or reading portfolio
+var (
+	ErrInsufficientHoldings = errors.New("insufficient holdings") // Sale exceeds amount held
+	ErrInvalidToken         = errors.New("invalid api token")     // API token is missing or unknown
+)
+
+// TradeSide tells whether transaction adds to or reduces holding
+type TradeSide string
+
+// Supported trade sides
+const (
+	TradeBuy  TradeSide = "buy"  // Coins bought
+	TradeSell TradeSide = "sell" // Coins sold
+)
+
+// Transaction represents single portfolio trade recorded by user
+// Price is per coin in quote currency of transaction
+type Transaction struct {
+	ID         int64     `db:"id"`          // Sequential transaction identifier
+	UserID     int64     `db:"telegram_id"` // Telegram user owning portfolio
+	CurrencyID string    `db:"currency_id"` // Traded cryptocurrency identifier
+	Quote      string    `db:"quote"`       // Quote currency of price
+	Side       TradeSide `db:"side"`        // Buy or sell
+	Amount     float64   `db:"amount"`      // Number of coins traded
+	Price      float64   `db:"price"`       // Price of one coin
+	ExecutedAt time.Time `db:"executed_at"` // Moment trade was recorded
+}
+
+// Position is holding of single coin derived from transactions with average cost method
+// Value and unrealized P&L are known only when Priced is set
+type Position struct {
+	CurrencyID    string  // Held cryptocurrency identifier
+	Quote         string  // Quote currency of all amounts
+	Amount        float64 // Number of coins held
+	CostBasis     float64 // Purchase cost of coins held
+	AveragePrice  float64 // Average purchase price of one coin held
+	CurrentPrice  float64 // Latest saved price
+	Value         float64 // Current value of coins held
+	RealizedPnL   float64 // Profit or loss locked in by sales
+	UnrealizedPnL float64 // Profit or loss of coins held at current price
+	Priced        bool    // Whether latest price is known
+}
+
+// PortfolioTotal sums positions expressed in one quote currency
+type PortfolioTotal struct {
+	Quote         string  // Quote currency of all amounts
+	Value         float64 // Current value of priced positions
+	CostBasis     float64 // Purchase cost of coins held
+	RealizedPnL   float64 // Profit or loss locked in by sales
+	UnrealizedPnL float64 // Profit or loss of priced positions
+}
+
+// Portfolio is user's positions with totals per quote currency
+type Portfolio struct {
+	Positions []*Position
+	Totals    []*PortfolioTotal
+}
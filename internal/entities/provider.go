@@ -0,0 +1,13 @@
+package entities
+
+import "time"
+
+// ProviderStatus represents health of external price provider
+// Reflects circuit breaker state guarding the provider
+type ProviderStatus struct {
+	Name      string    // Provider name
+	State     string    // Circuit state: closed, half-open or open
+	Failures  int       // Consecutive failed requests
+	LastError string    // Last request error, empty after success
+	RetryAt   time.Time // Earliest moment of next probe when circuit is open
+}
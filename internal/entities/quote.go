@@ -0,0 +1,16 @@
+package entities
+
+import "time"
+
+// PriceQuote represents price reported by single external provider
+// Raw market data kept alongside the aggregated price tick
+type PriceQuote struct {
+	CurrencyID string    `db:"currency_id"`       // Unique cryptocurrency identifier
+	VsCurrency string    `db:"quote"`             // Quote currency code, e.g. usd
+	Price      float64   `db:"price"`             // Reported market price
+	Volume     float64   `db:"volume"`            // 24h traded volume in quote currency, zero if unknown
+	Source     string    `db:"source"`            // Name of the price provider
+	ObservedAt time.Time `db:"observed_at"`       // Moment the price was received
+	Deviation  float64   `db:"deviation_percent"` // Deviation from median of all sources in percent
+	Excluded   bool      `db:"excluded"`          // Whether quote was rejected as outlier
+}
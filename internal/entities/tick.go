@@ -0,0 +1,15 @@
+package entities
+
+import "time"
+
+// PriceTick represents a single cryptocurrency price observation
+// Append-only history record used to derive current snapshots
+type PriceTick struct {
+	ID         int64         `db:"id"`          // Sequential observation identifier
+	CurrencyID string        `db:"currency_id"` // Unique cryptocurrency identifier
+	Quote      string        `db:"quote"`       // Quote currency code of the price
+	Price      float64       `db:"price"`       // Observed market price in quote currency
+	ObservedAt time.Time     `db:"observed_at"` // Moment the price was observed
+	Source     string        `db:"source"`      // Name of the price source or aggregation method
+	Quotes     []*PriceQuote `db:"-"`           // Per-source quotes the price was aggregated from
+}
@@ -6,12 +6,17 @@
 // - TelegramID: Unique user identifier from Telegram
 // - AutoSubscribe: Flag for automatic currency updates subscription
 // - SendInterval: Frequency of updates in minutes
+// - Language: Interface language chosen by user
+// - Watchlist: Coins user follows
 package entities
 
 // User represents Telegram bot user with preferences
 // Stores user settings for notification preferences
 type User struct {
-	TelegramID    int64 `db:"telegram_id"`    // Unique Telegram user identifier
-	AutoSubscribe bool  `db:"auto_subscribe"` // Automatic updates subscription status
-	SendInterval  uint  `db:"send_interval"`  // Update frequency in minutes
+	TelegramID    int64    `db:"telegram_id"`    // Unique Telegram user identifier
+	AutoSubscribe bool     `db:"auto_subscribe"` // Automatic updates subscription status
+	SendInterval  uint     `db:"send_interval"`  // Update frequency in minutes
+	Quote         string   `db:"quote"`          // Preferred quote currency code
+	Language      string   `db:"language"`       // Interface language chosen by user or detected from Telegram client
+	Watchlist     []string `db:"-"`              // Coins shown in rates and updates, all coins when empty
 }
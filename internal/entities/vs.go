@@ -0,0 +1,101 @@
+package entities
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// DefaultQuote is quote currency used when none is requested
+const DefaultQuote = "usd"
+
+// QuoteCurrency describes currency that cryptocurrency prices are expressed in
+type QuoteCurrency struct {
+	Code        string // Lowercase currency code as used by price providers
+	Symbol      string // Currency sign used in formatted prices
+	Decimals    int    // Number of fraction digits in formatted prices
+	SymbolAfter bool   // Whether sign follows the amount
+}
+
+// QuoteCurrencies contains supported quote currencies by code
+var QuoteCurrencies = map[string]QuoteCurrency{
+	"usd": {Code: "usd", Symbol: "$", Decimals: 2},
+	"eur": {Code: "eur", Symbol: "€", Decimals: 2},
+	"gbp": {Code: "gbp", Symbol: "£", Decimals: 2},
+	"rub": {Code: "rub", Symbol: "₽", Decimals: 2, SymbolAfter: true},
+	"btc": {Code: "btc", Symbol: "₿", Decimals: 8},
+	"eth": {Code: "eth", Symbol: "Ξ", Decimals: 6},
+}
+
+// LookupQuote finds supported quote currency by case-insensitive code
+func LookupQuote(code string) (QuoteCurrency, bool) {
+	quote, ok := QuoteCurrencies[strings.ToLower(code)]
+	return quote, ok
+}
+
+// QuoteSet contains quote currencies prices are fetched in
+// Other supported quote currencies are rejected as their prices never appear
+type QuoteSet struct {
+	codes []string
+}
+
+// NewQuoteSet creates set of fetched quote currencies from configured codes
+// Empty list means default quote only, unsupported codes are rejected
+func NewQuoteSet(codes []string) (QuoteSet, error) {
+	if len(codes) == 0 {
+		codes = []string{DefaultQuote}
+	}
+
+	set := QuoteSet{codes: make([]string, 0, len(codes))}
+	for _, code := range codes {
+		quote, ok := LookupQuote(code)
+		if !ok {
+			return QuoteSet{}, fmt.Errorf("%w: %s", ErrUnsupportedQuote, code)
+		}
+		if _, ok := set.Lookup(quote.Code); !ok {
+			set.codes = append(set.codes, quote.Code)
+		}
+	}
+	return set, nil
+}
+
+// Lookup finds quote currency of set by case-insensitive code
+func (s QuoteSet) Lookup(code string) (QuoteCurrency, bool) {
+	quote, ok := LookupQuote(code)
+	if !ok || !slices.Contains(s.codes, quote.Code) {
+		return QuoteCurrency{}, false
+	}
+	return quote, true
+}
+
+// Codes returns codes of quote currencies in configured order
+func (s QuoteSet) Codes() []string {
+	return slices.Clone(s.codes)
+}
+
+// Default returns quote used when none is requested
+// DefaultQuote when it is fetched, first configured quote otherwise
+func (s QuoteSet) Default() string {
+	if len(s.codes) == 0 || slices.Contains(s.codes, DefaultQuote) {
+		return DefaultQuote
+	}
+	return s.codes[0]
+}
+
+// Format formats price with currency sign and precision
+func (q QuoteCurrency) Format(price float64) string {
+	amount := fmt.Sprintf("%.*f", q.Decimals, price)
+	if q.SymbolAfter {
+		return amount + " " + q.Symbol
+	}
+	return q.Symbol + amount
+}
+
+// FormatPrice formats price in quote currency with given code
+// Unknown codes are formatted with two decimals and uppercase code
+func FormatPrice(price float64, code string) string {
+	if quote, ok := LookupQuote(code); ok {
+		return quote.Format(price)
+	}
+	return fmt.Sprintf("%.2f %s", price, strings.ToUpper(code))
+}
@@ -0,0 +1,194 @@
+// Package eventbus provides in-process typed publish/subscribe
+// Decouples event producers from consumers with bounded per-subscriber buffers
+package eventbus
+
+import (
+	"context"
+	"currencyhub/monitoring"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultBuffer is used when subscription buffer size is not positive
+const DefaultBuffer = 256
+
+// ErrClosed is returned when subscription is closed while event waits for delivery
+var ErrClosed = errors.New("subscription closed")
+
+// subscriber receives events of single type
+type subscriber interface {
+	deliver(event any) bool
+	deliverWait(ctx context.Context, event any) error
+	name() string
+	close()
+}
+
+// Bus dispatches published events to subscribers of their type
+// Publish never blocks, events are dropped for subscribers with full buffer
+type Bus struct {
+	mu          sync.RWMutex
+	subscribers map[reflect.Type][]subscriber
+}
+
+// New creates empty event bus
+func New() *Bus {
+	return &Bus{subscribers: make(map[reflect.Type][]subscriber)}
+}
+
+// Publish delivers event to every subscriber of its dynamic type
+// Implements EventPublisher
+func (b *Bus) Publish(event any) {
+	eventType := reflect.TypeOf(event)
+	eventName := eventType.Name()
+	monitoring.EventsPublished.WithLabelValues(eventName).Inc()
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, sub := range b.subscribers[eventType] {
+		if !sub.deliver(event) {
+			monitoring.EventsDropped.WithLabelValues(eventName, sub.name()).Inc()
+		}
+	}
+}
+
+// PublishWait delivers event to every subscriber of its dynamic type waiting for room in full buffers
+// Used for events that must not be lost, gives up when context is cancelled
+// Bus lock is not held while waiting, so slow subscriber does not block subscribing
+func (b *Bus) PublishWait(ctx context.Context, event any) error {
+	eventType := reflect.TypeOf(event)
+	eventName := eventType.Name()
+	monitoring.EventsPublished.WithLabelValues(eventName).Inc()
+
+	b.mu.RLock()
+	subs := append([]subscriber(nil), b.subscribers[eventType]...)
+	b.mu.RUnlock()
+
+	for _, sub := range subs {
+		if err := sub.deliverWait(ctx, event); err != nil {
+			monitoring.EventsDropped.WithLabelValues(eventName, sub.name()).Inc()
+			return fmt.Errorf("deliver %s to %s: %w", eventName, sub.name(), err)
+		}
+	}
+	return nil
+}
+
+// Close closes channels of all subscriptions
+// Events published afterwards are discarded
+func (b *Bus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for eventType, subs := range b.subscribers {
+		for _, sub := range subs {
+			sub.close()
+		}
+		delete(b.subscribers, eventType)
+	}
+}
+
+// Subscription receives events of type T through bounded channel
+type Subscription[T any] struct {
+	bus       *Bus
+	subName   string
+	events    chan T
+	dropped   atomic.Uint64
+	closeOnce sync.Once
+	// mu guards events channel against closing during waiting delivery, done wakes waiting senders
+	mu     sync.RWMutex
+	closed bool
+	done   chan struct{}
+}
+
+// Subscribe registers named subscriber of events of type T
+// Buffer bounds number of undelivered events kept for subscriber
+func Subscribe[T any](bus *Bus, name string, buffer int) *Subscription[T] {
+	if buffer <= 0 {
+		buffer = DefaultBuffer
+	}
+	sub := &Subscription[T]{bus: bus, subName: name, events: make(chan T, buffer), done: make(chan struct{})}
+
+	eventType := reflect.TypeFor[T]()
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+	bus.subscribers[eventType] = append(bus.subscribers[eventType], sub)
+
+	return sub
+}
+
+// C returns channel of received events, closed on unsubscribe
+func (s *Subscription[T]) C() <-chan T {
+	return s.events
+}
+
+// Dropped returns number of events lost because buffer was full
+func (s *Subscription[T]) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Unsubscribe removes subscription from bus and closes its channel
+func (s *Subscription[T]) Unsubscribe() {
+	eventType := reflect.TypeFor[T]()
+
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+
+	subs := s.bus.subscribers[eventType]
+	for i, sub := range subs {
+		if sub == subscriber(s) {
+			s.bus.subscribers[eventType] = append(subs[:i:i], subs[i+1:]...)
+			break
+		}
+	}
+	s.close()
+}
+
+// deliver queues event without blocking, counts it as dropped when buffer is full
+func (s *Subscription[T]) deliver(event any) bool {
+	select {
+	case s.events <- event.(T):
+		return true
+	default:
+		s.dropped.Add(1)
+		return false
+	}
+}
+
+// deliverWait queues event waiting for room in buffer, counts it as dropped when context is cancelled
+func (s *Subscription[T]) deliverWait(ctx context.Context, event any) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		return ErrClosed
+	}
+	select {
+	case s.events <- event.(T):
+		return nil
+	case <-s.done:
+		return ErrClosed
+	case <-ctx.Done():
+		s.dropped.Add(1)
+		return ctx.Err()
+	}
+}
+
+// name returns subscriber name used in metrics
+func (s *Subscription[T]) name() string {
+	return s.subName
+}
+
+// close closes event channel once, caller holds bus lock
+// Waiting deliveries are woken first so channel is never closed under them
+func (s *Subscription[T]) close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.closed = true
+		close(s.events)
+	})
+}
@@ -0,0 +1,98 @@
+package eventbus
+
+import (
+	"context"
+	"currencyhub/internal/entities"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBus_DeliversByType(t *testing.T) {
+	bus := New()
+	prices := Subscribe[entities.PriceUpdated](bus, "prices", 4)
+	coins := Subscribe[entities.CoinAdded](bus, "coins", 4)
+
+	tick := &entities.PriceTick{CurrencyID: "bitcoin", Quote: "usd", Price: 50000}
+	bus.Publish(entities.PriceUpdated{Tick: tick})
+
+	require.Len(t, prices.C(), 1)
+	assert.Same(t, tick, (<-prices.C()).Tick)
+	assert.Empty(t, coins.C())
+}
+
+func TestBus_DropsWhenBufferFull(t *testing.T) {
+	bus := New()
+	slow := Subscribe[entities.PriceUpdated](bus, "slow", 1)
+	fast := Subscribe[entities.PriceUpdated](bus, "fast", 4)
+
+	for i := 0; i < 3; i++ {
+		bus.Publish(entities.PriceUpdated{Tick: &entities.PriceTick{CurrencyID: "bitcoin"}})
+	}
+
+	assert.Len(t, slow.C(), 1)
+	assert.Equal(t, uint64(2), slow.Dropped())
+	assert.Len(t, fast.C(), 3)
+	assert.Zero(t, fast.Dropped())
+}
+
+func TestBus_PublishWait(t *testing.T) {
+	bus := New()
+	alerts := Subscribe[entities.AlertTriggered](bus, "alerts", 1)
+	event := entities.AlertTriggered{Alert: &entities.Alert{ID: 1}}
+
+	require.NoError(t, bus.PublishWait(context.Background(), event))
+
+	done := make(chan error)
+	go func() { done <- bus.PublishWait(context.Background(), event) }()
+	<-alerts.C()
+	require.NoError(t, <-done, "waits for room instead of dropping")
+	assert.Len(t, alerts.C(), 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, bus.PublishWait(ctx, event), context.Canceled)
+	assert.Equal(t, uint64(1), alerts.Dropped())
+}
+
+func TestBus_PublishWait_DoesNotBlockSubscribe(t *testing.T) {
+	bus := New()
+	alerts := Subscribe[entities.AlertTriggered](bus, "alerts", 1)
+	event := entities.AlertTriggered{Alert: &entities.Alert{ID: 1}}
+	require.NoError(t, bus.PublishWait(context.Background(), event))
+
+	done := make(chan error)
+	go func() { done <- bus.PublishWait(context.Background(), event) }()
+
+	Subscribe[entities.CoinAdded](bus, "coins", 1)
+	<-alerts.C()
+	assert.NoError(t, <-done)
+}
+
+func TestSubscription_CloseWakesWaitingDelivery(t *testing.T) {
+	bus := New()
+	alerts := Subscribe[entities.AlertTriggered](bus, "alerts", 1)
+	event := entities.AlertTriggered{Alert: &entities.Alert{ID: 1}}
+	require.NoError(t, alerts.deliverWait(context.Background(), event))
+
+	done := make(chan error)
+	go func() { done <- alerts.deliverWait(context.Background(), event) }()
+
+	alerts.Unsubscribe()
+	assert.ErrorIs(t, <-done, ErrClosed)
+}
+
+func TestBus_Unsubscribe(t *testing.T) {
+	bus := New()
+	sub := Subscribe[entities.CoinAdded](bus, "coins", 1)
+	sub.Unsubscribe()
+
+	bus.Publish(entities.CoinAdded{Coin: &entities.Coin{ID: "pepe"}})
+
+	_, ok := <-sub.C()
+	assert.False(t, ok, "channel is closed after unsubscribe")
+	assert.Zero(t, sub.Dropped())
+
+	bus.Close()
+}
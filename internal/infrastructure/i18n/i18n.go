@@ -0,0 +1,256 @@
+// Package i18n provides message catalogs with plural forms and locale aware number formatting
+// Missing translations fall back to fallback language and then to message key
+package i18n
+
+import (
+	"currencyhub/internal/entities"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidNumber is returned for user input that is not number or whose separators are ambiguous
+var ErrInvalidNumber = errors.New("invalid number")
+
+// plainNumber matches unsigned decimal number with point after separators are normalized
+var plainNumber = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+
+// Plural forms of CLDR plural rules used by supported languages
+const (
+	One   = "one"
+	Few   = "few"
+	Many  = "many"
+	Other = "other"
+)
+
+// Catalog holds fmt message formats by language and message key, literal percent sign is written as "%%"
+// Plural message is stored under key suffixed with "|" and plural form, e.g. "alerts|few"
+type Catalog map[string]map[string]string
+
+// locale describes number formatting and plural rule of language
+type locale struct {
+	group       string               // Thousands separator
+	decimal     string               // Decimal separator
+	symbolAfter bool                 // Whether currency sign always follows amount
+	plural      func(n int64) string // Plural form of integer count
+}
+
+// locales contains formatting rules by language
+// Languages without own rules are formatted as English
+var locales = map[string]locale{
+	"en": {group: ",", decimal: ".", plural: pluralEnglish},
+	"ru": {group: "\u00a0", decimal: ",", symbolAfter: true, plural: pluralRussian},
+}
+
+// Translator creates localizers over shared catalog
+type Translator struct {
+	catalog  Catalog
+	fallback string
+}
+
+// NewTranslator creates translator for catalog
+// Fallback language is used for unknown languages and missing messages
+func NewTranslator(catalog Catalog, fallback string) *Translator {
+	return &Translator{catalog: catalog, fallback: fallback}
+}
+
+// Localizer returns localizer for language
+// Languages missing in catalog get fallback language
+func (t *Translator) Localizer(language string) Localizer {
+	if _, ok := t.catalog[language]; !ok {
+		language = t.fallback
+	}
+	return Localizer{language: language, translator: t}
+}
+
+// Localizer formats messages and numbers in single language
+type Localizer struct {
+	language   string
+	translator *Translator
+}
+
+// Language returns language code of localizer
+func (l Localizer) Language() string {
+	return l.language
+}
+
+// T formats message by key with given arguments
+func (l Localizer) T(key string, args ...any) string {
+	return fmt.Sprintf(l.lookup(key), args...)
+}
+
+// N formats plural message by key choosing form by count
+// Count is not passed to format implicitly, it goes among arguments when shown
+// All forms of language are tried before forms of fallback language
+func (l Localizer) N(key string, count int64, args ...any) string {
+	for _, language := range []string{l.language, l.translator.fallback} {
+		catalog := l.translator.catalog[language]
+		for _, form := range []string{localeOf(language).plural(count), Other, Many} {
+			if format, ok := catalog[key+"|"+form]; ok {
+				return fmt.Sprintf(format, args...)
+			}
+		}
+	}
+	return fmt.Sprintf(l.lookup(key+"|"+Other), args...)
+}
+
+// Number formats number with fixed fraction digits, thousands and decimal separators of language
+func (l Localizer) Number(value float64, decimals int) string {
+	formatted := strconv.FormatFloat(math.Abs(value), 'f', decimals, 64)
+	return l.group(formatted, value < 0)
+}
+
+// ParseNumber parses unsigned number typed by user with separators of language
+// Point is accepted as decimal separator everywhere, thousands separators must group exactly three digits
+// Rejects input where separator could mean both, e.g. "2,5" in English or "2,500.5" in Russian
+func (l Localizer) ParseNumber(text string) (float64, error) {
+	rules := l.locale()
+	normalized := strings.NewReplacer(" ", "", "\u00a0", "", "\u202f", "").Replace(strings.TrimSpace(text))
+
+	if rules.decimal != "." && strings.Contains(normalized, rules.decimal) {
+		if strings.Contains(normalized, ".") {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidNumber, text)
+		}
+		normalized = strings.ReplaceAll(normalized, rules.decimal, ".")
+	}
+	if separator := strings.TrimSpace(rules.group); separator != "" {
+		integer, fraction, _ := strings.Cut(normalized, ".")
+		if strings.Contains(fraction, separator) || !groupedByThousands(integer, separator) {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidNumber, text)
+		}
+		normalized = strings.ReplaceAll(normalized, separator, "")
+	}
+
+	if !plainNumber.MatchString(normalized) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidNumber, text)
+	}
+	return strconv.ParseFloat(normalized, 64)
+}
+
+// groupedByThousands reports whether separators in integer part split it into groups of three digits
+// Integer without separators is grouped trivially
+func groupedByThousands(integer, separator string) bool {
+	groups := strings.Split(integer, separator)
+	if len(groups) == 1 {
+		return true
+	}
+	if len(groups[0]) == 0 || len(groups[0]) > 3 {
+		return false
+	}
+	for _, group := range groups[1:] {
+		if len(group) != 3 {
+			return false
+		}
+	}
+	return true
+}
+
+// Amount formats quantity with up to eight fraction digits, trailing zeros removed
+func (l Localizer) Amount(value float64) string {
+	formatted := strconv.FormatFloat(math.Abs(value), 'f', 8, 64)
+	if strings.Contains(formatted, ".") {
+		formatted = strings.TrimRight(strings.TrimRight(formatted, "0"), ".")
+	}
+	return l.group(formatted, value < 0)
+}
+
+// Price formats price with currency sign and precision of quote currency
+// Unknown codes are formatted with two decimals and uppercase code
+func (l Localizer) Price(value float64, code string) string {
+	quote, ok := entities.LookupQuote(code)
+	if !ok {
+		return l.Number(value, 2) + " " + strings.ToUpper(code)
+	}
+	amount := l.Number(value, quote.Decimals)
+	if quote.SymbolAfter || l.locale().symbolAfter {
+		return amount + " " + quote.Symbol
+	}
+	return quote.Symbol + amount
+}
+
+// Percent formats signed percent change with two decimals
+func (l Localizer) Percent(value float64) string {
+	formatted := l.Number(value, 2) + "%"
+	if value >= 0 {
+		return "+" + formatted
+	}
+	return formatted
+}
+
+// lookup finds message format in language, then in fallback language
+// Returns key itself when message is missing everywhere
+func (l Localizer) lookup(key string) string {
+	if format, ok := l.find(key); ok {
+		return format
+	}
+	return key
+}
+
+// find looks message up in language of localizer and in fallback language
+func (l Localizer) find(key string) (string, bool) {
+	if format, ok := l.translator.catalog[l.language][key]; ok {
+		return format, true
+	}
+	format, ok := l.translator.catalog[l.translator.fallback][key]
+	return format, ok
+}
+
+// locale returns formatting rules of language
+func (l Localizer) locale() locale {
+	return localeOf(l.language)
+}
+
+// localeOf returns formatting rules of language, English rules for unknown languages
+func localeOf(language string) locale {
+	if rules, ok := locales[language]; ok {
+		return rules
+	}
+	return locales["en"]
+}
+
+// group inserts thousands separators into integer part and replaces decimal point
+func (l Localizer) group(formatted string, negative bool) string {
+	rules := l.locale()
+	integer, fraction, hasFraction := strings.Cut(formatted, ".")
+
+	var grouped strings.Builder
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			grouped.WriteString(rules.group)
+		}
+		grouped.WriteRune(digit)
+	}
+	if hasFraction {
+		grouped.WriteString(rules.decimal + fraction)
+	}
+
+	if negative && strings.Trim(formatted, "0.") != "" {
+		return "-" + grouped.String()
+	}
+	return grouped.String()
+}
+
+// pluralEnglish selects English plural form
+func pluralEnglish(n int64) string {
+	if n == 1 || n == -1 {
+		return One
+	}
+	return Other
+}
+
+// pluralRussian selects Russian plural form
+func pluralRussian(n int64) string {
+	if n < 0 {
+		n = -n
+	}
+	switch {
+	case n%10 == 1 && n%100 != 11:
+		return One
+	case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+		return Few
+	}
+	return Many
+}
@@ -0,0 +1,112 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testCatalog = Catalog{
+	"en": {
+		"hello":         "Hello, %s",
+		"alerts|one":    "%d alert",
+		"alerts|other":  "%d alerts",
+		"only_fallback": "English only",
+		"coins|one":     "%d coin",
+		"coins|other":   "%d coins",
+	},
+	"ru": {
+		"hello":       "Привет, %s",
+		"alerts|one":  "%d алерт",
+		"alerts|few":  "%d алерта",
+		"alerts|many": "%d алертов",
+		"coins|one":   "%d монета",
+		"coins|many":  "%d монет",
+	},
+}
+
+func TestLocalizer_Messages(t *testing.T) {
+	translator := NewTranslator(testCatalog, "en")
+
+	assert.Equal(t, "Привет, Bob", translator.Localizer("ru").T("hello", "Bob"))
+	assert.Equal(t, "English only", translator.Localizer("ru").T("only_fallback"))
+	assert.Equal(t, "missing", translator.Localizer("ru").T("missing"))
+	assert.Equal(t, "en", translator.Localizer("de").Language())
+}
+
+func TestLocalizer_Plural(t *testing.T) {
+	translator := NewTranslator(testCatalog, "en")
+	en, ru := translator.Localizer("en"), translator.Localizer("ru")
+
+	assert.Equal(t, "1 alert", en.N("alerts", 1, 1))
+	assert.Equal(t, "5 alerts", en.N("alerts", 5, 5))
+
+	tests := map[int64]string{1: "1 алерт", 3: "3 алерта", 5: "5 алертов", 11: "11 алертов", 21: "21 алерт", 22: "22 алерта", 112: "112 алертов"}
+	for n, expected := range tests {
+		assert.Equal(t, expected, ru.N("alerts", n, n))
+	}
+
+	assert.Equal(t, "3 монет", ru.N("coins", 3, 3))
+	assert.Equal(t, "3 coins", translator.Localizer("de").N("coins", 3, 3))
+}
+
+func TestLocalizer_Numbers(t *testing.T) {
+	translator := NewTranslator(testCatalog, "en")
+	en, ru := translator.Localizer("en"), translator.Localizer("ru")
+
+	assert.Equal(t, "1,234,567.89", en.Number(1234567.891, 2))
+	assert.Equal(t, "1\u00a0234\u00a0567,89", ru.Number(1234567.891, 2))
+	assert.Equal(t, "-950.00", en.Number(-950, 2))
+	assert.Equal(t, "0.00", en.Number(-0.001, 2))
+
+	assert.Equal(t, "0.5", en.Amount(0.5))
+	assert.Equal(t, "12,000", en.Amount(12000))
+
+	assert.Equal(t, "$62,000.00", en.Price(62000, "usd"))
+	assert.Equal(t, "62\u00a0000,00 $", ru.Price(62000, "usd"))
+	assert.Equal(t, "5,000.00 ₽", en.Price(5000, "rub"))
+	assert.Equal(t, "1.00 XYZ", en.Price(1, "xyz"))
+
+	assert.Equal(t, "+1.50%", en.Percent(1.5))
+	assert.Equal(t, "-0,25%", ru.Percent(-0.25))
+}
+
+func TestLocalizer_ParseNumber(t *testing.T) {
+	translator := NewTranslator(testCatalog, "en")
+	en, ru := translator.Localizer("en"), translator.Localizer("ru")
+
+	tests := []struct {
+		localizer Localizer
+		input     string
+		expected  float64
+		valid     bool
+	}{
+		{en, "2500", 2500, true},
+		{en, "2,500", 2500, true},
+		{en, "62,000.50", 62000.5, true},
+		{en, "1,234,567", 1234567, true},
+		{en, "0.5", 0.5, true},
+		{en, "2,5", 0, false},
+		{en, "2,50.5", 0, false},
+		{en, "1.5,000", 0, false},
+		{en, ",500", 0, false},
+		{ru, "2,5", 2.5, true},
+		{ru, "2.5", 2.5, true},
+		{ru, "62\u00a0000,50", 62000.5, true},
+		{ru, "2,500.5", 0, false},
+		{en, "1e3", 0, false},
+		{en, "0x10", 0, false},
+		{en, "", 0, false},
+	}
+
+	for _, tt := range tests {
+		value, err := tt.localizer.ParseNumber(tt.input)
+		if !tt.valid {
+			assert.ErrorIs(t, err, ErrInvalidNumber, "%s %q", tt.localizer.Language(), tt.input)
+			continue
+		}
+		if assert.NoError(t, err, "%s %q", tt.localizer.Language(), tt.input) {
+			assert.Equal(t, tt.expected, value, "%s %q", tt.localizer.Language(), tt.input)
+		}
+	}
+}
@@ -14,7 +14,7 @@ func SetupLogger(logFile string) *slog.Logger {
 	if logFile != "" {
 		file, err := os.OpenFile(logFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
 		if err != nil {
-			fmt.Errorf("Failed to open log file: %w", err)
+			fmt.Fprintf(os.Stderr, "Failed to open log file: %v\n", err)
 			return nil
 		}
 		logHandler = slog.NewJSONHandler(file, &slog.HandlerOptions{
@@ -0,0 +1,23 @@
+// Package interfaces defines price alert contracts
+// Abstracts storage of user alert rules
+package interfaces
+
+import (
+	"context"
+	"currencyhub/internal/entities"
+	"time"
+)
+
+// AlertRepository defines interface for price alert operations
+// Provides contract for database interactions with user alert rules
+type AlertRepository interface {
+	CreateAlert(ctx context.Context, alert *entities.Alert) error                                // Stores alert and fills its identifier and creation time
+	GetUserAlerts(ctx context.Context, userID int64) ([]*entities.Alert, error)                  // Gets active alerts of user ordered by creation
+	CountUserAlerts(ctx context.Context, userID int64) (int, error)                              // Counts active alerts of user
+	DeleteAlert(ctx context.Context, userID, alertID int64) error                                // Deletes alert owned by user
+	GetActiveAlerts(ctx context.Context, currencyID, quote string) ([]*entities.Alert, error)    // Gets alerts not fired yet for coin in quote currency
+	MarkTriggered(ctx context.Context, alertID int64, at time.Time) (bool, error)                // Marks threshold alert fired, false when it already was
+	UnmarkTriggered(ctx context.Context, alertID int64, at time.Time) error                      // Reactivates threshold alert marked fired at given time
+	MarkMoveFired(ctx context.Context, alertID int64, at time.Time) (bool, error)                // Records move alert firing, false while cooldown lasts
+	UnmarkMoveFired(ctx context.Context, alertID int64, at time.Time, previous *time.Time) error // Restores previous firing of move alert fired at given time
+}
@@ -0,0 +1,19 @@
+// Package interfaces defines coin catalog contracts
+// Abstracts storage of supported cryptocurrencies
+package interfaces
+
+import (
+	"context"
+	"currencyhub/internal/entities"
+)
+
+// CoinRepository defines interface for coin catalog operations
+// Provides contract for database interactions with supported coins
+type CoinRepository interface {
+	GetCoins(ctx context.Context, enabledOnly bool) ([]*entities.Coin, error) // Gets catalog coins ordered by addition
+	GetCoin(ctx context.Context, id string) (*entities.Coin, error)           // Gets single catalog coin
+	AddCoin(ctx context.Context, coin *entities.Coin) error                   // Adds coin or updates its symbol and name
+	SetCoinEnabled(ctx context.Context, id string, enabled bool) error        // Enables or disables coin tracking
+	GetAliases(ctx context.Context) (map[string]string, error)                // Gets alternative coin names mapped to coin identifiers
+	AddAlias(ctx context.Context, alias, coinID string) error                 // Adds alternative name of coin
+}
@@ -5,13 +5,21 @@ package interfaces
 import (
 	"context"
 	"currencyhub/internal/entities"
+	"time"
 )
 
 // CurrencyRepository defines interface for currency data operations
 // Provides contract for database interactions with currency rates
 type CurrencyRepository interface {
-	GetLatestByCurrency(ctx context.Context, currencyID string) (*entities.CurrencyRate, error) // Gets latest rate for specific currency
-	GetRates(ctx context.Context) ([]*entities.CurrencyRate, error)                             // Gets all current currency rates
-	CheckList(coin string) bool                                                                 // Validates currency exists in supported list
-	SavePrice(ctx context.Context, coinID string, price float64) error                          //updates currency data in database with new price information
+	GetLatestByCurrency(ctx context.Context, currencyID, quote string) (*entities.CurrencyRate, error)                                              // Gets latest rate for specific currency
+	GetRates(ctx context.Context, quote string) ([]*entities.CurrencyRate, error)                                                                   // Gets all current currency rates
+	CheckList(ctx context.Context, coin string) (bool, error)                                                                                       // Validates currency exists in supported list
+	SavePrice(ctx context.Context, tick *entities.PriceTick) error                                                                                  // Appends price observation to history and refreshes snapshot
+	MarkStale(ctx context.Context, quote string, currencyIDs []string) error                                                                        // Flags snapshots of currencies that received no price
+	GetPriceAt(ctx context.Context, currencyID, quote string, at time.Time) (*entities.PriceTick, error)                                            // Gets last observation made at or before given moment
+	GetTicks(ctx context.Context, currencyID, quote string, from, to time.Time) ([]*entities.PriceTick, error)                                      // Gets price observations within time range
+	GetLatestQuotes(ctx context.Context, currencyID, quote string) ([]*entities.PriceQuote, error)                                                  // Gets per-source quotes behind latest price
+	GetCandles(ctx context.Context, currencyID, quote string, interval entities.CandleInterval, from, to time.Time) ([]*entities.Candle, error)     // Gets OHLC rollups within time range
+	GetHistory(ctx context.Context, currencyID, quote string, from, to time.Time, step time.Duration) ([]*entities.HistoryPoint, error)             // Gets price observations averaged into step sized buckets
+	GetTicksAfter(ctx context.Context, currencyID, quote string, after entities.TickCursor, to time.Time, limit int) ([]*entities.PriceTick, error) // Gets page of price observations following cursor
 }
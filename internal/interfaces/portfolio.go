@@ -0,0 +1,17 @@
+// Package interfaces defines portfolio contracts
+// Abstracts storage of user trades and API tokens
+package interfaces
+
+import (
+	"context"
+	"currencyhub/internal/entities"
+)
+
+// PortfolioRepository defines interface for portfolio operations
+// Provides contract for database interactions with user trades and portfolio API tokens
+type PortfolioRepository interface {
+	AddTransaction(ctx context.Context, transaction *entities.Transaction) error        // Stores trade and fills its identifier and execution time
+	GetTransactions(ctx context.Context, userID int64) ([]*entities.Transaction, error) // Gets trades of user in execution order
+	SaveTokenHash(ctx context.Context, userID int64, tokenHash string) error            // Stores API token hash replacing previous token of user
+	GetUserByTokenHash(ctx context.Context, tokenHash string) (int64, error)            // Gets user owning API token, ErrInvalidToken when unknown
+}
@@ -0,0 +1,21 @@
+// Package interfaces defines price provider contracts
+// Abstracts external market data sources from persistence
+package interfaces
+
+import (
+	"context"
+	"currencyhub/internal/entities"
+)
+
+// PriceProvider defines interface for external price sources
+// Implementations fetch prices of given coins in given quote currencies
+type PriceProvider interface {
+	Name() string                                                                                    // Returns provider name used as tick source
+	FetchPrices(ctx context.Context, coinIDs, vsCurrencies []string) ([]*entities.PriceQuote, error) // Fetches current prices for coins
+}
+
+// ProviderStatusReporter defines interface for price provider health reporting
+// Exposes circuit breaker state of every configured provider
+type ProviderStatusReporter interface {
+	ProviderStatuses() []*entities.ProviderStatus // Returns provider states in configured order
+}
@@ -0,0 +1,12 @@
+// Package interfaces defines event publishing contracts
+// Decouples event producers from in-process bus implementation
+package interfaces
+
+import "context"
+
+// EventPublisher defines interface for publishing domain events
+// Implementations dispatch by event type, Publish must not block
+type EventPublisher interface {
+	Publish(event any)                                // Delivers event to its subscribers, dropping it for full buffers
+	PublishWait(ctx context.Context, event any) error // Delivers event waiting for room in subscriber buffers
+}
@@ -4,14 +4,22 @@ package interfaces
 
 import (
 	"context"
-	_ "currencyhub/internal/entities"
+	"currencyhub/internal/entities"
 )
 
 // UserRepository defines interface for user data operations
 // Provides contract for database interactions with user preferences
 type UserRepository interface {
-	GetSubscribedUsers(ctx context.Context) (map[int64]uint, error)          // Gets all users with auto-subscription enabled
-	SetAutoSubscribe(ctx context.Context, userID int64, interval uint) error // Enables auto-subscription for user
-	DisableAutoSubscribe(ctx context.Context, userID int64) error            // Disables auto-subscription for user
-	GetUserSendInterval(ctx context.Context, userID int64) (uint, error)     // Gets user's update interval setting
+	GetSubscribedUsers(ctx context.Context) ([]*entities.User, error)                      // Gets all users with auto-subscription enabled together with their watchlists
+	SetAutoSubscribe(ctx context.Context, userID int64, interval uint) error               // Enables auto-subscription for user
+	DisableAutoSubscribe(ctx context.Context, userID int64) error                          // Disables auto-subscription for user
+	GetUserSendInterval(ctx context.Context, userID int64) (uint, error)                   // Gets user's update interval setting
+	GetUserQuote(ctx context.Context, userID int64) (string, error)                        // Gets user's preferred quote currency
+	SetUserQuote(ctx context.Context, userID int64, quote string) error                    // Sets user's preferred quote currency
+	GetUserLanguage(ctx context.Context, userID int64) (string, bool, error)               // Gets user's interface language and whether user chose it
+	SetUserLanguage(ctx context.Context, userID int64, language string, chosen bool) error // Sets user's interface language, detected one is not chosen
+	GetWatchlist(ctx context.Context, userID int64) ([]string, error)                      // Gets coins user follows in order they were added
+	AddToWatchlist(ctx context.Context, userID int64, coinIDs []string) error              // Adds coins to user's watchlist
+	RemoveFromWatchlist(ctx context.Context, userID int64, coinIDs []string) error         // Removes coins from user's watchlist
+	ClearWatchlist(ctx context.Context, userID int64) error                                // Removes all coins from user's watchlist
 }
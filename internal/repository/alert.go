@@ -0,0 +1,156 @@
+// Package repository provides PostgreSQL implementation of AlertRepository
+// Handles database operations for user price alerts
+package repository
+
+import (
+	"context"
+	"currencyhub/internal/entities"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+// AlertRepo implements AlertRepository interface for PostgreSQL
+// Provides concrete database operations for price alerts
+type AlertRepo struct {
+	db *sqlx.DB
+}
+
+// NewAlertRepo creates price alert data access service
+// Initializes with database connection dependency
+func NewAlertRepo(db *sqlx.DB) *AlertRepo {
+	return &AlertRepo{db: db}
+}
+
+// alertColumns lists price_alerts columns mapped to Alert fields
+const alertColumns = `id, telegram_id, currency_id, quote, condition, threshold,
+	window_seconds, cooldown_seconds, created_at, triggered_at, last_fired_at`
+
+// CreateAlert stores new price alert
+// Fills alert identifier and creation time assigned by database
+func (r *AlertRepo) CreateAlert(ctx context.Context, alert *entities.Alert) error {
+	query := `INSERT INTO price_alerts (telegram_id, currency_id, quote, condition, threshold, window_seconds, cooldown_seconds)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at`
+
+	err := r.db.QueryRowxContext(ctx, query, alert.UserID, alert.CurrencyID, alert.Quote, alert.Condition, alert.Threshold,
+		alert.WindowSeconds, alert.CooldownSeconds).
+		Scan(&alert.ID, &alert.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create alert: %w", err)
+	}
+	return nil
+}
+
+// GetUserAlerts retrieves active alerts of user
+// Alerts are ordered by creation so identifiers grow down the list
+func (r *AlertRepo) GetUserAlerts(ctx context.Context, userID int64) ([]*entities.Alert, error) {
+	query := `SELECT ` + alertColumns + ` FROM price_alerts
+		WHERE telegram_id = $1 AND triggered_at IS NULL
+		ORDER BY id`
+
+	var alerts []*entities.Alert
+	if err := r.db.SelectContext(ctx, &alerts, query, userID); err != nil {
+		return nil, fmt.Errorf("failed to get user alerts: %w", err)
+	}
+	return alerts, nil
+}
+
+// CountUserAlerts counts active alerts of user
+func (r *AlertRepo) CountUserAlerts(ctx context.Context, userID int64) (int, error) {
+	var count int
+	query := `SELECT COUNT(*) FROM price_alerts WHERE telegram_id = $1 AND triggered_at IS NULL`
+
+	if err := r.db.GetContext(ctx, &count, query, userID); err != nil {
+		return 0, fmt.Errorf("failed to count user alerts: %w", err)
+	}
+	return count, nil
+}
+
+// DeleteAlert removes alert owned by user
+// Returns ErrAlertNotFound when user has no such alert
+func (r *AlertRepo) DeleteAlert(ctx context.Context, userID, alertID int64) error {
+	query := `DELETE FROM price_alerts WHERE id = $1 AND telegram_id = $2`
+
+	result, err := r.db.ExecContext(ctx, query, alertID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete alert: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete alert: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%w: %d", entities.ErrAlertNotFound, alertID)
+	}
+	return nil
+}
+
+// GetActiveAlerts retrieves alerts of coin in quote currency that have not fired yet
+// Move alerts are never marked triggered and stay active
+func (r *AlertRepo) GetActiveAlerts(ctx context.Context, currencyID, quote string) ([]*entities.Alert, error) {
+	query := `SELECT ` + alertColumns + ` FROM price_alerts
+		WHERE currency_id = $1 AND quote = $2 AND triggered_at IS NULL
+		ORDER BY id`
+
+	var alerts []*entities.Alert
+	if err := r.db.SelectContext(ctx, &alerts, query, currencyID, quote); err != nil {
+		return nil, fmt.Errorf("failed to get active alerts: %w", err)
+	}
+	return alerts, nil
+}
+
+// MarkMoveFired records moment move alert fired
+// Returns false while alert cooldown since previous firing has not passed yet
+func (r *AlertRepo) MarkMoveFired(ctx context.Context, alertID int64, at time.Time) (bool, error) {
+	query := `UPDATE price_alerts SET last_fired_at = $2
+		WHERE id = $1 AND (last_fired_at IS NULL OR last_fired_at + cooldown_seconds * INTERVAL '1 second' <= $2)`
+
+	result, err := r.db.ExecContext(ctx, query, alertID, at)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark alert fired: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to mark alert fired: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// MarkTriggered records moment alert fired
+// Returns false when alert was deleted or already fired, so it is never notified twice
+func (r *AlertRepo) MarkTriggered(ctx context.Context, alertID int64, at time.Time) (bool, error) {
+	query := `UPDATE price_alerts SET triggered_at = $2 WHERE id = $1 AND triggered_at IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query, alertID, at)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark alert triggered: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to mark alert triggered: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// UnmarkTriggered reactivates threshold alert whose notification could not be delivered
+// Only mark made at given time is cleared, so newer firing is kept
+func (r *AlertRepo) UnmarkTriggered(ctx context.Context, alertID int64, at time.Time) error {
+	query := `UPDATE price_alerts SET triggered_at = NULL WHERE id = $1 AND triggered_at = $2`
+
+	if _, err := r.db.ExecContext(ctx, query, alertID, at); err != nil {
+		return fmt.Errorf("failed to unmark alert triggered: %w", err)
+	}
+	return nil
+}
+
+// UnmarkMoveFired restores previous firing of move alert whose notification could not be delivered
+// Only firing recorded at given time is reverted, so newer firing is kept
+func (r *AlertRepo) UnmarkMoveFired(ctx context.Context, alertID int64, at time.Time, previous *time.Time) error {
+	query := `UPDATE price_alerts SET last_fired_at = $3 WHERE id = $1 AND last_fired_at = $2`
+
+	if _, err := r.db.ExecContext(ctx, query, alertID, at, previous); err != nil {
+		return fmt.Errorf("failed to unmark alert fired: %w", err)
+	}
+	return nil
+}
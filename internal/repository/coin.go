@@ -0,0 +1,118 @@
+// Package repository provides PostgreSQL implementation of CoinRepository
+// Handles database operations for coin catalog management
+package repository
+
+import (
+	"context"
+	"currencyhub/internal/entities"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+)
+
+// CoinRepo implements CoinRepository interface for PostgreSQL
+// Provides concrete database operations for coin catalog
+type CoinRepo struct {
+	db *sqlx.DB
+}
+
+// NewCoinRepo creates coin catalog data access service
+// Initializes with database connection dependency
+func NewCoinRepo(db *sqlx.DB) *CoinRepo {
+	return &CoinRepo{db: db}
+}
+
+// GetCoins retrieves coins from catalog
+// Returns only tracked coins when enabledOnly is set
+func (r *CoinRepo) GetCoins(ctx context.Context, enabledOnly bool) ([]*entities.Coin, error) {
+	query := `SELECT id, symbol, name, enabled, added_at FROM coins
+		WHERE enabled OR NOT $1
+		ORDER BY added_at, id`
+
+	var coins []*entities.Coin
+	if err := r.db.SelectContext(ctx, &coins, query, enabledOnly); err != nil {
+		return nil, fmt.Errorf("failed to get coins: %w", err)
+	}
+	return coins, nil
+}
+
+// GetCoin retrieves single coin from catalog
+// Returns ErrCoinNotFound when coin is not in catalog
+func (r *CoinRepo) GetCoin(ctx context.Context, id string) (*entities.Coin, error) {
+	var coin entities.Coin
+	query := `SELECT id, symbol, name, enabled, added_at FROM coins WHERE id = $1`
+
+	err := r.db.GetContext(ctx, &coin, query, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", entities.ErrCoinNotFound, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get coin: %w", err)
+	}
+	return &coin, nil
+}
+
+// AddCoin adds coin to catalog
+// Existing coin keeps its enabled flag and gets symbol and name updated
+func (r *CoinRepo) AddCoin(ctx context.Context, coin *entities.Coin) error {
+	query := `INSERT INTO coins (id, symbol, name, enabled)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (id) DO UPDATE SET symbol = EXCLUDED.symbol, name = EXCLUDED.name
+		RETURNING enabled, added_at`
+
+	err := r.db.QueryRowxContext(ctx, query, coin.ID, coin.Symbol, coin.Name, coin.Enabled).
+		Scan(&coin.Enabled, &coin.AddedAt)
+	if err != nil {
+		return fmt.Errorf("failed to add coin: %w", err)
+	}
+	return nil
+}
+
+// SetCoinEnabled enables or disables coin tracking
+// Returns ErrCoinNotFound when coin is not in catalog
+func (r *CoinRepo) SetCoinEnabled(ctx context.Context, id string, enabled bool) error {
+	query := `UPDATE coins SET enabled = $2 WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, id, enabled)
+	if err != nil {
+		return fmt.Errorf("failed to update coin: %w", err)
+	}
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return fmt.Errorf("%w: %s", entities.ErrCoinNotFound, id)
+	}
+	return nil
+}
+
+// GetAliases retrieves alternative coin names
+// Returns map of lowercase alias to coin identifier
+func (r *CoinRepo) GetAliases(ctx context.Context) (map[string]string, error) {
+	type alias struct {
+		Alias  string `db:"alias"`
+		CoinID string `db:"coin_id"`
+	}
+
+	var rows []alias
+	query := `SELECT alias, coin_id FROM coin_aliases`
+	if err := r.db.SelectContext(ctx, &rows, query); err != nil {
+		return nil, fmt.Errorf("failed to get coin aliases: %w", err)
+	}
+
+	aliases := make(map[string]string, len(rows))
+	for _, row := range rows {
+		aliases[row.Alias] = row.CoinID
+	}
+	return aliases, nil
+}
+
+// AddAlias adds alternative name of coin
+// Existing alias is reassigned to given coin
+func (r *CoinRepo) AddAlias(ctx context.Context, alias, coinID string) error {
+	query := `INSERT INTO coin_aliases (alias, coin_id) VALUES ($1, $2)
+		ON CONFLICT (alias) DO UPDATE SET coin_id = EXCLUDED.coin_id`
+
+	if _, err := r.db.ExecContext(ctx, query, alias, coinID); err != nil {
+		return fmt.Errorf("failed to add coin alias: %w", err)
+	}
+	return nil
+}
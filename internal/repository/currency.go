@@ -6,17 +6,17 @@ import (
 	"context"
 	"currencyhub/internal/entities"
 	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/jmoiron/sqlx"
-	"log/slog"
+	"github.com/lib/pq"
 	"time"
 )
 
 // CurrencyRepo implements CurrencyRepository interface for PostgreSQL
 // Provides concrete database operations for currency data
 type CurrencyRepo struct {
-	db     *sqlx.DB
-	logger *slog.Logger
+	db *sqlx.DB
 }
 
 // NewCurrencyRepo creates new currency repository instance
@@ -27,206 +27,355 @@ func NewCurrencyRepo(db *sqlx.DB) *CurrencyRepo {
 
 // GetLatestByCurrency retrieves latest rate for specific currency
 // Returns most recent currency rate data from database
-func (r *CurrencyRepo) GetLatestByCurrency(ctx context.Context, currencyID string) (*entities.CurrencyRate, error) {
-	if !r.CheckList(currencyID) {
-		return nil, fmt.Errorf("currency not found: %s", currencyID)
+func (r *CurrencyRepo) GetLatestByCurrency(ctx context.Context, currencyID, quote string) (*entities.CurrencyRate, error) {
+	enabled, err := r.CheckList(ctx, currencyID)
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return nil, fmt.Errorf("%w: %s", entities.ErrCurrencyNotFound, currencyID)
+	}
+	if _, ok := entities.LookupQuote(quote); !ok {
+		return nil, fmt.Errorf("%w: %s", entities.ErrUnsupportedQuote, quote)
 	}
 
 	var cr entities.CurrencyRate
-	query := `SELECT currency_id, current_price, min_price, max_price, change_percent,
-		hour_min_price, hour_max_price, time_stamp, date
-		FROM currencies WHERE currency_id = $1 ORDER BY time_stamp DESC LIMIT 1`
+	query := `SELECT currency_id, quote, current_price, min_price, max_price, change_percent,
+		change_24h, change_7d, hour_min_price, hour_max_price, time_stamp, date, stale
+		FROM currencies WHERE currency_id = $1 AND quote = $2 ORDER BY time_stamp DESC LIMIT 1`
 
-	err := r.db.GetContext(ctx, &cr, query, currencyID)
+	err = r.db.GetContext(ctx, &cr, query, currencyID, quote)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("currency not found: %s", currencyID)
+			return nil, fmt.Errorf("%w: %s", entities.ErrCurrencyNotFound, currencyID)
 		}
-		r.logger.Error("Failed to get currency rate", "currency", currencyID, "error", err)
-		return nil, err
+		return nil, fmt.Errorf("failed to get %s rate: %w", currencyID, err)
 	}
 	return &cr, nil
 }
 
 // GetRates retrieves latest rates for all available cryptocurrencies
 // Returns current market data for all supported currencies
-func (r *CurrencyRepo) GetRates(ctx context.Context) ([]*entities.CurrencyRate, error) {
+func (r *CurrencyRepo) GetRates(ctx context.Context, quote string) ([]*entities.CurrencyRate, error) {
 	query := `
         SELECT DISTINCT ON (currency_id) 
-            currency_id, current_price, min_price, max_price, change_percent,
-            hour_min_price, hour_max_price, time_stamp, date
+            currency_id, quote, current_price, min_price, max_price, change_percent,
+            change_24h, change_7d, hour_min_price, hour_max_price, time_stamp, date, stale
         FROM currencies 
+        WHERE quote = $1 AND currency_id IN (SELECT id FROM coins WHERE enabled)
         ORDER BY currency_id, time_stamp DESC
     `
 
 	var crs []*entities.CurrencyRate
-	err := r.db.SelectContext(ctx, &crs, query)
+	err := r.db.SelectContext(ctx, &crs, query, quote)
 	if err != nil {
-		r.logger.Error("Failed to get rates", "error", err)
-		return nil, err
+		return nil, fmt.Errorf("failed to get rates: %w", err)
 	}
 
 	return crs, nil
 }
 
-// CheckList validates currency exists in supported list
-// Returns true if currency is supported by application
-func (r *CurrencyRepo) CheckList(coin string) bool {
+// CheckList validates currency is enabled in coin catalog
+// Returns error when catalog cannot be read
+func (r *CurrencyRepo) CheckList(ctx context.Context, coin string) (bool, error) {
+	var enabled bool
+	query := `SELECT EXISTS (SELECT 1 FROM coins WHERE id = $1 AND enabled)`
 
-	for _, i := range entities.CurrencyList {
-		if i == coin {
-			return true
-		}
+	if err := r.db.GetContext(ctx, &enabled, query, coin); err != nil {
+		return false, fmt.Errorf("failed to check coin %s: %w", coin, err)
 	}
-	return false
+	return enabled, nil
 }
 
-// SavePrice updates currency data in database with new price information
-// Maintains hourly and daily statistics for price tracking
-func (r *CurrencyRepo) SavePrice(ctx context.Context, coinID string, price float64) error {
-	existingRate, err := r.GetDataInfo(ctx, coinID)
+// SavePrice appends price observation to history and refreshes snapshot
+// Snapshot statistics are derived from stored ticks inside one transaction
+func (r *CurrencyRepo) SavePrice(ctx context.Context, tick *entities.PriceTick) error {
+	tick.ObservedAt = tick.ObservedAt.UTC()
+
+	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			now := time.Now().UTC()
-			existingRate = &entities.CurrencyRate{
-				CurrencyID:   coinID,
-				CurrentPrice: price,
-				MinPrice:     price,
-				MaxPrice:     price,
-				HourMinPrice: price,
-				HourMaxPrice: price,
-				TimeStamp:    now,
-				Date:         time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC),
-			}
-		} else {
-			return fmt.Errorf("failed to get currency data for %s: %w", coinID, err)
-		}
-	} else {
-		existingRate.CurrentPrice = price
+		return fmt.Errorf("failed to begin transaction for %s: %w", tick.CurrencyID, err)
 	}
+	defer tx.Rollback()
 
-	if err := r.UpdateDailyData(existingRate); err != nil {
-		return fmt.Errorf("failed to update daily stats for %s: %w", coinID, err)
+	if err := r.InsertTick(ctx, tx, tick); err != nil {
+		return fmt.Errorf("failed to save price tick for %s: %w", tick.CurrencyID, err)
 	}
 
-	if err := r.UpdateHourlyStats(existingRate); err != nil {
-		return fmt.Errorf("failed to update hourly stats for %s: %w", coinID, err)
+	if err := r.InsertQuotes(ctx, tx, tick); err != nil {
+		return fmt.Errorf("failed to save source quotes for %s: %w", tick.CurrencyID, err)
 	}
 
-	if err := r.WriteToBase(ctx, existingRate); err != nil {
-		return fmt.Errorf("failed to save currency data for %s: %w", coinID, err)
+	if err := r.UpsertCandles(ctx, tx, tick); err != nil {
+		return fmt.Errorf("failed to update candles for %s: %w", tick.CurrencyID, err)
 	}
 
+	rate, err := r.BuildSnapshot(ctx, tx, tick)
+	if err != nil {
+		return fmt.Errorf("failed to build snapshot for %s: %w", tick.CurrencyID, err)
+	}
+
+	if err := r.WriteToBase(ctx, tx, rate); err != nil {
+		return fmt.Errorf("failed to save currency data for %s: %w", tick.CurrencyID, err)
+	}
+
+	return tx.Commit()
+}
+
+// MarkStale flags snapshots of currencies that received no price
+// Flag is cleared by next successfully saved price
+func (r *CurrencyRepo) MarkStale(ctx context.Context, quote string, currencyIDs []string) error {
+	query := `UPDATE currencies SET stale = TRUE WHERE quote = $1 AND currency_id = ANY($2)`
+
+	_, err := r.db.ExecContext(ctx, query, quote, pq.Array(currencyIDs))
+	if err != nil {
+		return fmt.Errorf("failed to mark stale currencies: %w", err)
+	}
 	return nil
 }
 
-// GetDataInfo retrieves currency information from database
-// Returns complete currency rate record for specified coin
-// Only for SavePrice
-func (r *CurrencyRepo) GetDataInfo(ctx context.Context, coinID string) (*entities.CurrencyRate, error) {
-	var currency entities.CurrencyRate
-
-	query := `SELECT currency_id, current_price, min_price, max_price, change_percent, 
-		hour_min_price, hour_max_price, time_stamp, date 
-		FROM currencies WHERE currency_id = $1`
-
-	err := r.db.QueryRowContext(ctx, query, coinID).Scan(
-		&currency.CurrencyID,
-		&currency.CurrentPrice,
-		&currency.MinPrice,
-		&currency.MaxPrice,
-		&currency.ChangePercent,
-		&currency.HourMinPrice,
-		&currency.HourMaxPrice,
-		&currency.TimeStamp,
-		&currency.Date,
-	)
+// GetPriceAt retrieves last price observation made at or before given moment
+// Returns ErrCurrencyNotFound when history starts later
+func (r *CurrencyRepo) GetPriceAt(ctx context.Context, currencyID, quote string, at time.Time) (*entities.PriceTick, error) {
+	var tick entities.PriceTick
+	query := `SELECT id, currency_id, quote, price, observed_at, source
+		FROM price_ticks WHERE currency_id = $1 AND quote = $2 AND observed_at <= $3
+		ORDER BY observed_at DESC, id DESC LIMIT 1`
+
+	err := r.db.GetContext(ctx, &tick, query, currencyID, quote, at.UTC())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: no price of %s in %s at %s", entities.ErrCurrencyNotFound, currencyID, quote, at.Format(time.RFC3339))
+	}
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to get price of %s at %s: %w", currencyID, at.Format(time.RFC3339), err)
+	}
+	return &tick, nil
+}
+
+// GetTicks retrieves price observations within time range
+// Returns ticks ordered from oldest to newest
+func (r *CurrencyRepo) GetTicks(ctx context.Context, currencyID, quote string, from, to time.Time) ([]*entities.PriceTick, error) {
+	query := `SELECT id, currency_id, quote, price, observed_at, source
+		FROM price_ticks WHERE currency_id = $1 AND quote = $2 AND observed_at >= $3 AND observed_at <= $4
+		ORDER BY observed_at, id`
+
+	var ticks []*entities.PriceTick
+	err := r.db.SelectContext(ctx, &ticks, query, currencyID, quote, from.UTC(), to.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticks for %s: %w", currencyID, err)
+	}
+	return ticks, nil
+}
+
+// GetLatestQuotes retrieves per-source quotes the latest price was aggregated from
+// Returns empty slice for prices saved without source breakdown
+func (r *CurrencyRepo) GetLatestQuotes(ctx context.Context, currencyID, quote string) ([]*entities.PriceQuote, error) {
+	query := `SELECT t.currency_id, t.quote, q.source, q.price, q.volume, q.deviation_percent, q.excluded, t.observed_at
+		FROM price_quotes q JOIN price_ticks t ON t.id = q.tick_id
+		WHERE q.tick_id = (
+			SELECT id FROM price_ticks WHERE currency_id = $1 AND quote = $2
+			ORDER BY observed_at DESC, id DESC LIMIT 1
+		)
+		ORDER BY q.source`
+
+	var quotes []*entities.PriceQuote
+	err := r.db.SelectContext(ctx, &quotes, query, currencyID, quote)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source quotes for %s: %w", currencyID, err)
+	}
+	return quotes, nil
+}
+
+// GetCandles retrieves OHLC rollups of given resolution within time range
+// Returns candles ordered by bucket start time
+func (r *CurrencyRepo) GetCandles(ctx context.Context, currencyID, quote string, interval entities.CandleInterval, from, to time.Time) ([]*entities.Candle, error) {
+	query := `SELECT currency_id, quote, resolution, open_time, open_price, high_price, low_price, close_price, ticks
+		FROM candles WHERE currency_id = $1 AND quote = $2 AND resolution = $3 AND open_time >= $4 AND open_time <= $5
+		ORDER BY open_time`
+
+	var candles []*entities.Candle
+	err := r.db.SelectContext(ctx, &candles, query, currencyID, quote, interval, from.UTC(), to.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s candles for %s: %w", interval, currencyID, err)
+	}
+	return candles, nil
+}
+
+// GetHistory retrieves price observations averaged into step sized buckets
+// Buckets are aligned to unix epoch and ordered by start time
+func (r *CurrencyRepo) GetHistory(ctx context.Context, currencyID, quote string, from, to time.Time, step time.Duration) ([]*entities.HistoryPoint, error) {
+	query := `SELECT to_timestamp(floor(extract(epoch FROM observed_at) / $5::float8) * $5::float8) AT TIME ZONE 'UTC' AS bucket,
+			AVG(price) AS price, MIN(price) AS min_price, MAX(price) AS max_price, COUNT(*) AS ticks
+		FROM price_ticks WHERE currency_id = $1 AND quote = $2 AND observed_at >= $3 AND observed_at <= $4
+		GROUP BY bucket
+		ORDER BY bucket`
+
+	var points []*entities.HistoryPoint
+	err := r.db.SelectContext(ctx, &points, query, currencyID, quote, from.UTC(), to.UTC(), step.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get history for %s: %w", currencyID, err)
+	}
+	return points, nil
+}
+
+// GetTicksAfter retrieves page of price observations following cursor up to range end
+// Returns at most limit ticks ordered from oldest to newest
+func (r *CurrencyRepo) GetTicksAfter(ctx context.Context, currencyID, quote string, after entities.TickCursor, to time.Time, limit int) ([]*entities.PriceTick, error) {
+	query := `SELECT id, currency_id, quote, price, observed_at, source
+		FROM price_ticks WHERE currency_id = $1 AND quote = $2 AND (observed_at, id) > ($3, $4) AND observed_at <= $5
+		ORDER BY observed_at, id
+		LIMIT $6`
+
+	var ticks []*entities.PriceTick
+	err := r.db.SelectContext(ctx, &ticks, query, currencyID, quote, after.ObservedAt.UTC(), after.ID, to.UTC(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticks for %s: %w", currencyID, err)
 	}
-	return &currency, nil
+	return ticks, nil
 }
 
-// UpdateDailyData handles daily price statistics updates.
-// Maintains daily min/max price tracking.
+// InsertQuotes stores per-source quotes of aggregated price tick
 // Only for SavePrice
-func (r *CurrencyRepo) UpdateDailyData(rate *entities.CurrencyRate) error {
-	now := time.Now()
-	currentDate := now.UTC().Truncate(24 * time.Hour)
-
-	if rate.Date.Before(currentDate) {
-		rate.MinPrice = rate.CurrentPrice
-		rate.MaxPrice = rate.CurrentPrice
-		rate.Date = currentDate
-	} else {
-		if rate.CurrentPrice < rate.MinPrice {
-			rate.MinPrice = rate.CurrentPrice
-		}
-		if rate.CurrentPrice > rate.MaxPrice {
-			rate.MaxPrice = rate.CurrentPrice
+func (r *CurrencyRepo) InsertQuotes(ctx context.Context, tx *sqlx.Tx, tick *entities.PriceTick) error {
+	query := `INSERT INTO price_quotes (tick_id, source, price, volume, deviation_percent, excluded)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	for _, quote := range tick.Quotes {
+		_, err := tx.ExecContext(ctx, query, tick.ID, quote.Source, quote.Price, quote.Volume, quote.Deviation, quote.Excluded)
+		if err != nil {
+			return fmt.Errorf("failed to insert %s quote: %w", quote.Source, err)
 		}
 	}
-
 	return nil
 }
 
-// UpdateHourlyStats handles hourly price tracking and statistics
-// Calculates hourly min/max prices and change percentages
+// UpsertCandles folds price observation into candle of every resolution
+// Assumes ticks of one currency arrive in chronological order
 // Only for SavePrice
-func (r *CurrencyRepo) UpdateHourlyStats(rate *entities.CurrencyRate) error {
-	if time.Since(rate.TimeStamp) >= time.Hour {
-		rate.HourMinPrice = rate.CurrentPrice
-		rate.HourMaxPrice = rate.CurrentPrice
-		rate.TimeStamp = time.Now()
-	} else {
-		if rate.CurrentPrice < rate.HourMinPrice {
-			rate.HourMinPrice = rate.CurrentPrice
-		}
-		if rate.CurrentPrice > rate.HourMaxPrice {
-			rate.HourMaxPrice = rate.CurrentPrice
+func (r *CurrencyRepo) UpsertCandles(ctx context.Context, tx *sqlx.Tx, tick *entities.PriceTick) error {
+	query := `
+        INSERT INTO candles
+            (currency_id, quote, resolution, open_time, open_price, high_price, low_price, close_price, ticks)
+        VALUES ($1, $2, $3, $4, $5, $5, $5, $5, 1)
+        ON CONFLICT (currency_id, quote, resolution, open_time)
+        DO UPDATE SET
+            high_price = GREATEST(candles.high_price, EXCLUDED.high_price),
+            low_price = LEAST(candles.low_price, EXCLUDED.low_price),
+            close_price = EXCLUDED.close_price,
+            ticks = candles.ticks + 1
+    `
+	for _, interval := range entities.CandleIntervals {
+		openTime := tick.ObservedAt.Truncate(interval.Duration())
+		if _, err := tx.ExecContext(ctx, query, tick.CurrencyID, tick.Quote, interval, openTime, tick.Price); err != nil {
+			return fmt.Errorf("failed to upsert %s candle: %w", interval, err)
 		}
 	}
+	return nil
+}
 
-	if rate.HourMinPrice == 0 {
-		rate.ChangePercent = 0
-	} else {
-		rate.ChangePercent = (rate.HourMaxPrice - rate.HourMinPrice) / rate.HourMinPrice * 100
+// InsertTick appends single price observation to history table
+// Only for SavePrice
+func (r *CurrencyRepo) InsertTick(ctx context.Context, tx *sqlx.Tx, tick *entities.PriceTick) error {
+	query := `INSERT INTO price_ticks (currency_id, quote, price, observed_at, source)
+		VALUES ($1, $2, $3, $4, $5) RETURNING id`
+
+	return tx.QueryRowxContext(ctx, query, tick.CurrencyID, tick.Quote, tick.Price, tick.ObservedAt, tick.Source).Scan(&tick.ID)
+}
+
+// BuildSnapshot derives current currency statistics from stored ticks
+// Daily extremes cover the UTC day, hourly extremes the last hour of observations
+// Changes compare price with last observation made hour, day and week before, 0 when history is shorter
+// Only for SavePrice
+func (r *CurrencyRepo) BuildSnapshot(ctx context.Context, tx *sqlx.Tx, tick *entities.PriceTick) (*entities.CurrencyRate, error) {
+	observed := tick.ObservedAt
+	date := time.Date(observed.Year(), observed.Month(), observed.Day(), 0, 0, 0, 0, time.UTC)
+	hourAgo := observed.Add(-time.Hour)
+
+	query := `SELECT
+			MIN(price) FILTER (WHERE observed_at >= $2) AS min_price,
+			MAX(price) FILTER (WHERE observed_at >= $2) AS max_price,
+			MIN(price) FILTER (WHERE observed_at >= $3) AS hour_min_price,
+			MAX(price) FILTER (WHERE observed_at >= $3) AS hour_max_price
+		FROM price_ticks
+		WHERE currency_id = $1 AND quote = $5 AND observed_at >= LEAST($2::timestamp, $3::timestamp) AND observed_at <= $4`
+
+	rate := &entities.CurrencyRate{
+		CurrencyID:   tick.CurrencyID,
+		Quote:        tick.Quote,
+		CurrentPrice: tick.Price,
+		TimeStamp:    observed,
+		Date:         date,
 	}
-	return nil
+
+	err := tx.QueryRowxContext(ctx, query, tick.CurrencyID, date, hourAgo, observed, tick.Quote).Scan(
+		&rate.MinPrice,
+		&rate.MaxPrice,
+		&rate.HourMinPrice,
+		&rate.HourMaxPrice,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	baseQuery := `SELECT
+			(SELECT price FROM price_ticks WHERE currency_id = $1 AND quote = $2 AND observed_at <= $3
+				ORDER BY observed_at DESC, id DESC LIMIT 1) AS hour_price,
+			(SELECT price FROM price_ticks WHERE currency_id = $1 AND quote = $2 AND observed_at <= $4
+				ORDER BY observed_at DESC, id DESC LIMIT 1) AS day_price,
+			(SELECT price FROM price_ticks WHERE currency_id = $1 AND quote = $2 AND observed_at <= $5
+				ORDER BY observed_at DESC, id DESC LIMIT 1) AS week_price`
+
+	var hourPrice, dayPrice, weekPrice sql.NullFloat64
+	err = tx.QueryRowxContext(ctx, baseQuery, tick.CurrencyID, tick.Quote,
+		hourAgo, observed.Add(-24*time.Hour), observed.Add(-7*24*time.Hour)).Scan(&hourPrice, &dayPrice, &weekPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	rate.Change1h = entities.ChangePercent(tick.Price, hourPrice.Float64)
+	rate.Change24h = entities.ChangePercent(tick.Price, dayPrice.Float64)
+	rate.Change7d = entities.ChangePercent(tick.Price, weekPrice.Float64)
+	return rate, nil
 }
 
-// WriteToBase inserts or updates currency record with statistics
-// Implements upsert operation for currency data management
+// WriteToBase inserts or replaces currency snapshot with statistics
+// Snapshot is fully derived from price_ticks, so existing values are overwritten
 // Only for SavePrice
-func (r *CurrencyRepo) WriteToBase(ctx context.Context, currency *entities.CurrencyRate) error {
+func (r *CurrencyRepo) WriteToBase(ctx context.Context, tx *sqlx.Tx, currency *entities.CurrencyRate) error {
 	query := `
         INSERT INTO currencies 
-            (currency_id, current_price, min_price, max_price, change_percent, 
-            hour_min_price, hour_max_price, time_stamp, date)
-        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-        ON CONFLICT (currency_id) 
+            (currency_id, quote, current_price, min_price, max_price, change_percent, 
+            hour_min_price, hour_max_price, time_stamp, date, stale, change_24h, change_7d)
+        VALUES ($1, $10, $2, $3, $4, $5, $6, $7, $8, $9, FALSE, $11, $12)
+        ON CONFLICT (currency_id, quote) 
         DO UPDATE SET
+            stale = FALSE,
             current_price = EXCLUDED.current_price,
-            min_price = LEAST(currencies.min_price, EXCLUDED.min_price),
-            max_price = GREATEST(currencies.max_price, EXCLUDED.max_price),
+            min_price = EXCLUDED.min_price,
+            max_price = EXCLUDED.max_price,
             change_percent = EXCLUDED.change_percent,
-            hour_min_price = LEAST(currencies.hour_min_price, EXCLUDED.hour_min_price),
-            hour_max_price = GREATEST(currencies.hour_max_price, EXCLUDED.hour_max_price),
+            change_24h = EXCLUDED.change_24h,
+            change_7d = EXCLUDED.change_7d,
+            hour_min_price = EXCLUDED.hour_min_price,
+            hour_max_price = EXCLUDED.hour_max_price,
             time_stamp = EXCLUDED.time_stamp,
             date = EXCLUDED.date
     `
-	_, err := r.db.ExecContext(
+	_, err := tx.ExecContext(
 		ctx,
 		query,
 		currency.CurrencyID,
 		currency.CurrentPrice,
 		currency.MinPrice, // min_price
 		currency.MaxPrice, // max_price
-		currency.ChangePercent,
+		currency.Change1h,
 		currency.HourMinPrice, // hour_min_price
 		currency.HourMaxPrice, // hour_max_price
 		currency.TimeStamp,    // time_stamp
 		currency.Date,         // date
+		currency.Quote,        // quote
+		currency.Change24h,    // change_24h
+		currency.Change7d,     // change_7d
 	)
 
 	if err != nil {
@@ -0,0 +1,83 @@
+// Package repository provides PostgreSQL implementation of PortfolioRepository
+// Handles database operations for user trades and API tokens
+package repository
+
+import (
+	"context"
+	"currencyhub/internal/entities"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+)
+
+// PortfolioRepo implements PortfolioRepository interface for PostgreSQL
+// Provides concrete database operations for portfolios
+type PortfolioRepo struct {
+	db *sqlx.DB
+}
+
+// NewPortfolioRepo creates portfolio data access service
+// Initializes with database connection dependency
+func NewPortfolioRepo(db *sqlx.DB) *PortfolioRepo {
+	return &PortfolioRepo{db: db}
+}
+
+// AddTransaction stores user trade
+// Fills transaction identifier and execution time assigned by database
+func (r *PortfolioRepo) AddTransaction(ctx context.Context, transaction *entities.Transaction) error {
+	query := `INSERT INTO portfolio_transactions (telegram_id, currency_id, quote, side, amount, price)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, executed_at`
+
+	err := r.db.QueryRowxContext(ctx, query, transaction.UserID, transaction.CurrencyID, transaction.Quote,
+		transaction.Side, transaction.Amount, transaction.Price).
+		Scan(&transaction.ID, &transaction.ExecutedAt)
+	if err != nil {
+		return fmt.Errorf("failed to add transaction: %w", err)
+	}
+	return nil
+}
+
+// GetTransactions retrieves trades of user
+// Returns trades ordered from oldest to newest
+func (r *PortfolioRepo) GetTransactions(ctx context.Context, userID int64) ([]*entities.Transaction, error) {
+	query := `SELECT id, telegram_id, currency_id, quote, side, amount, price, executed_at
+		FROM portfolio_transactions WHERE telegram_id = $1
+		ORDER BY executed_at, id`
+
+	var transactions []*entities.Transaction
+	if err := r.db.SelectContext(ctx, &transactions, query, userID); err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+	return transactions, nil
+}
+
+// SaveTokenHash stores hash of user's API token
+// Previous token of user stops working
+func (r *PortfolioRepo) SaveTokenHash(ctx context.Context, userID int64, tokenHash string) error {
+	query := `INSERT INTO api_tokens (token_hash, telegram_id)
+		VALUES ($1, $2)
+		ON CONFLICT (telegram_id) DO UPDATE SET token_hash = EXCLUDED.token_hash, created_at = NOW()`
+
+	if _, err := r.db.ExecContext(ctx, query, tokenHash, userID); err != nil {
+		return fmt.Errorf("failed to save api token: %w", err)
+	}
+	return nil
+}
+
+// GetUserByTokenHash retrieves user owning API token
+// Returns ErrInvalidToken when no user has such token
+func (r *PortfolioRepo) GetUserByTokenHash(ctx context.Context, tokenHash string) (int64, error) {
+	var userID int64
+	query := `SELECT telegram_id FROM api_tokens WHERE token_hash = $1`
+
+	err := r.db.GetContext(ctx, &userID, query, tokenHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, entities.ErrInvalidToken
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get api token: %w", err)
+	}
+	return userID, nil
+}
@@ -4,16 +4,18 @@ package repository
 
 import (
 	"context"
+	"currencyhub/internal/entities"
+	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/jmoiron/sqlx"
-	"log/slog"
+	"github.com/lib/pq"
 )
 
 // UserRepo implements UserRepository interface for PostgreSQL
 // Provides concrete database operations for user data
 type UserRepo struct {
-	db     *sqlx.DB
-	logger slog.Logger
+	db *sqlx.DB
 }
 
 // NewUserService creates user data access service
@@ -23,27 +25,33 @@ func NewUserService(db *sqlx.DB) *UserRepo {
 }
 
 // GetSubscribedUsers retrieves all users with auto-subscription enabled
-// Returns map of user IDs to their update intervals
-func (du *UserRepo) GetSubscribedUsers(ctx context.Context) (map[int64]uint, error) {
+// Returns users with update intervals, quote currencies, languages and watchlists
+func (du *UserRepo) GetSubscribedUsers(ctx context.Context) ([]*entities.User, error) {
 	type result struct {
-		TelegramID   int64 `db:"telegram_id"`
-		SendInterval uint  `db:"send_interval"`
+		entities.User
+		Watchlist pq.StringArray `db:"watchlist"`
 	}
 
-	var users []result
-	query := `SELECT telegram_id, send_interval FROM users WHERE auto_subscribe = true`
-	err := du.db.SelectContext(ctx, &users, query)
+	var rows []result
+	query := `SELECT u.telegram_id, u.auto_subscribe, u.send_interval, u.quote, u.language,
+			COALESCE(ARRAY_AGG(uc.coin_id ORDER BY uc.added_at, uc.coin_id) FILTER (WHERE uc.coin_id IS NOT NULL), '{}') AS watchlist
+		FROM users u
+		LEFT JOIN user_coins uc ON uc.telegram_id = u.telegram_id
+		WHERE u.auto_subscribe = true
+		GROUP BY u.telegram_id`
+	err := du.db.SelectContext(ctx, &rows, query)
 	if err != nil {
-		du.logger.Error("Failed to get subscribed users", "error", err)
 		return nil, fmt.Errorf("failed to get subscribed users: %w", err)
 	}
 
-	resultMap := make(map[int64]uint)
-	for _, u := range users {
-		resultMap[u.TelegramID] = u.SendInterval
+	users := make([]*entities.User, 0, len(rows))
+	for _, row := range rows {
+		user := row.User
+		user.Watchlist = row.Watchlist
+		users = append(users, &user)
 	}
 
-	return resultMap, nil
+	return users, nil
 }
 
 // SetAutoSubscribe enables automatic updates for a user
@@ -57,7 +65,6 @@ DO UPDATE SET
     send_interval = EXCLUDED.send_interval;`
 	_, err := du.db.ExecContext(ctx, query, interval, userID)
 	if err != nil {
-		du.logger.Error("Failed to set auto subscribe", "userID", userID, "error", err)
 		return fmt.Errorf("failed to set auto subscribe: %w", err)
 	}
 	return nil
@@ -69,7 +76,6 @@ func (du *UserRepo) DisableAutoSubscribe(ctx context.Context, userID int64) erro
 	query := `UPDATE users SET auto_subscribe = false, send_interval = 0 WHERE telegram_id = $1`
 	_, err := du.db.ExecContext(ctx, query, userID)
 	if err != nil {
-		du.logger.Error("Failed to disable auto subscribe", "userID", userID, "error", err)
 		return fmt.Errorf("failed to disable auto subscribe: %w", err)
 	}
 	return nil
@@ -82,8 +88,113 @@ func (du *UserRepo) GetUserSendInterval(ctx context.Context, userID int64) (uint
 	query := `SELECT send_interval FROM users WHERE telegram_id = $1`
 	err := du.db.GetContext(ctx, &interval, query, userID)
 	if err != nil {
-		du.logger.Error("Failed to get user send interval", "userID", userID, "error", err)
 		return 0, fmt.Errorf("failed to get user send interval: %w", err)
 	}
 	return interval, nil
 }
+
+// GetUserQuote retrieves preferred quote currency of a user
+// Returns default quote for users without stored preference
+func (du *UserRepo) GetUserQuote(ctx context.Context, userID int64) (string, error) {
+	var quote string
+	query := `SELECT quote FROM users WHERE telegram_id = $1`
+	err := du.db.GetContext(ctx, &quote, query, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entities.DefaultQuote, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get user quote: %w", err)
+	}
+	return quote, nil
+}
+
+// SetUserQuote stores preferred quote currency of a user
+// Creates user record when it does not exist yet
+func (du *UserRepo) SetUserQuote(ctx context.Context, userID int64, quote string) error {
+	query := `INSERT INTO users (telegram_id, quote)
+VALUES ($1, $2)
+ON CONFLICT (telegram_id)
+DO UPDATE SET quote = EXCLUDED.quote`
+	_, err := du.db.ExecContext(ctx, query, userID, quote)
+	if err != nil {
+		return fmt.Errorf("failed to set user quote: %w", err)
+	}
+	return nil
+}
+
+// GetUserLanguage retrieves interface language of a user and whether user chose it
+// Returns empty string for users without stored language
+func (du *UserRepo) GetUserLanguage(ctx context.Context, userID int64) (string, bool, error) {
+	var row struct {
+		Language string `db:"language"`
+		Chosen   bool   `db:"language_chosen"`
+	}
+	query := `SELECT language, language_chosen FROM users WHERE telegram_id = $1`
+	err := du.db.GetContext(ctx, &row, query, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get user language: %w", err)
+	}
+	return row.Language, row.Chosen, nil
+}
+
+// SetUserLanguage stores interface language of a user
+// Creates user record when it does not exist yet
+func (du *UserRepo) SetUserLanguage(ctx context.Context, userID int64, language string, chosen bool) error {
+	query := `INSERT INTO users (telegram_id, language, language_chosen)
+VALUES ($1, $2, $3)
+ON CONFLICT (telegram_id)
+DO UPDATE SET language = EXCLUDED.language, language_chosen = EXCLUDED.language_chosen`
+	_, err := du.db.ExecContext(ctx, query, userID, language, chosen)
+	if err != nil {
+		return fmt.Errorf("failed to set user language: %w", err)
+	}
+	return nil
+}
+
+// GetWatchlist retrieves coins user follows
+// Returns coin identifiers in order they were added
+func (du *UserRepo) GetWatchlist(ctx context.Context, userID int64) ([]string, error) {
+	coinIDs := []string{}
+	query := `SELECT coin_id FROM user_coins WHERE telegram_id = $1 ORDER BY added_at, coin_id`
+	if err := du.db.SelectContext(ctx, &coinIDs, query, userID); err != nil {
+		return nil, fmt.Errorf("failed to get watchlist: %w", err)
+	}
+	return coinIDs, nil
+}
+
+// AddToWatchlist adds coins to user's watchlist
+// Coins already in watchlist keep their position
+func (du *UserRepo) AddToWatchlist(ctx context.Context, userID int64, coinIDs []string) error {
+	query := `INSERT INTO user_coins (telegram_id, coin_id, added_at)
+		SELECT $1, coin_id, NOW() + pos * INTERVAL '1 microsecond'
+		FROM UNNEST($2::text[]) WITH ORDINALITY AS watched(coin_id, pos)
+		ON CONFLICT (telegram_id, coin_id) DO NOTHING`
+	_, err := du.db.ExecContext(ctx, query, userID, pq.Array(coinIDs))
+	if err != nil {
+		return fmt.Errorf("failed to add coins to watchlist: %w", err)
+	}
+	return nil
+}
+
+// RemoveFromWatchlist removes coins from user's watchlist
+func (du *UserRepo) RemoveFromWatchlist(ctx context.Context, userID int64, coinIDs []string) error {
+	query := `DELETE FROM user_coins WHERE telegram_id = $1 AND coin_id = ANY($2)`
+	_, err := du.db.ExecContext(ctx, query, userID, pq.Array(coinIDs))
+	if err != nil {
+		return fmt.Errorf("failed to remove coins from watchlist: %w", err)
+	}
+	return nil
+}
+
+// ClearWatchlist removes all coins from user's watchlist
+func (du *UserRepo) ClearWatchlist(ctx context.Context, userID int64) error {
+	query := `DELETE FROM user_coins WHERE telegram_id = $1`
+	_, err := du.db.ExecContext(ctx, query, userID)
+	if err != nil {
+		return fmt.Errorf("failed to clear watchlist: %w", err)
+	}
+	return nil
+}
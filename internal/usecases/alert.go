@@ -0,0 +1,220 @@
+// Price alert use cases.
+// Contains:
+// - User alert rule management
+// - Alert evaluation against saved prices
+package usecase
+
+import (
+	"context"
+	"currencyhub/internal/entities"
+	"currencyhub/internal/interfaces"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+)
+
+// MaxUserAlerts limits number of active alerts single user may have
+const MaxUserAlerts = 20
+
+// MinAlertWindow and MaxAlertWindow bound period move alerts measure price change over
+// Cooldown of move alert defaults to its window
+const (
+	MinAlertWindow = time.Minute
+	MaxAlertWindow = 7 * 24 * time.Hour
+)
+
+// AlertPublishTimeout bounds waiting for notification consumer to take fired alert
+// Stalled consumer must not block evaluation of further prices forever
+const AlertPublishTimeout = 30 * time.Second
+
+// AlertUseCase provides business logic operations for price alerts
+// Evaluates alerts against prices saved by fetcher and publishes fired ones
+type AlertUseCase struct {
+	alertRepo    interfaces.AlertRepository
+	currencyRepo interfaces.CurrencyRepository
+	quotes       entities.QuoteSet
+	events       interfaces.EventPublisher
+	logger       *slog.Logger
+}
+
+// NewAlertUseCase creates a new instance of AlertUseCase
+// with the provided alert and currency repositories, fetched quote currencies, event publisher and logger
+func NewAlertUseCase(alertRepo interfaces.AlertRepository, currencyRepo interfaces.CurrencyRepository, quotes entities.QuoteSet, events interfaces.EventPublisher, logger *slog.Logger) *AlertUseCase {
+	return &AlertUseCase{alertRepo: alertRepo, currencyRepo: currencyRepo, quotes: quotes, events: events, logger: logger}
+}
+
+// CreateAlert validates and stores user alert
+// Rejects threshold alert whose condition already holds for current price, as it could never cross the threshold
+func (uc *AlertUseCase) CreateAlert(ctx context.Context, alert *entities.Alert) error {
+	if !alert.Condition.IsValid() {
+		return &entities.ValidationError{Field: "condition", Message: fmt.Sprintf("invalid alert condition: %q", alert.Condition)}
+	}
+	if alert.Threshold <= 0 || math.IsInf(alert.Threshold, 0) || math.IsNaN(alert.Threshold) {
+		return &entities.ValidationError{Field: "threshold", Message: "threshold must be positive number"}
+	}
+	if alert.Condition.IsMove() {
+		if err := normalizeMoveAlert(alert); err != nil {
+			return err
+		}
+	} else {
+		alert.WindowSeconds, alert.CooldownSeconds = 0, 0
+	}
+	quote, ok := uc.quotes.Lookup(alert.Quote)
+	if !ok {
+		return fmt.Errorf("%w: %s", entities.ErrUnsupportedQuote, alert.Quote)
+	}
+	alert.Quote = quote.Code
+
+	count, err := uc.alertRepo.CountUserAlerts(ctx, alert.UserID)
+	if err != nil {
+		return err
+	}
+	if count >= MaxUserAlerts {
+		return fmt.Errorf("%w: limit is %d", entities.ErrTooManyAlerts, MaxUserAlerts)
+	}
+
+	if !alert.Condition.IsMove() {
+		rate, err := uc.currencyRepo.GetLatestByCurrency(ctx, alert.CurrencyID, alert.Quote)
+		if err != nil && !errors.Is(err, entities.ErrCurrencyNotFound) {
+			return err
+		}
+		if rate != nil && alert.Condition.Met(rate.CurrentPrice, alert.Threshold) {
+			return fmt.Errorf("%w: %s is %s", entities.ErrAlertConditionMet, alert.CurrencyID, entities.FormatPrice(rate.CurrentPrice, alert.Quote))
+		}
+	}
+
+	return uc.alertRepo.CreateAlert(ctx, alert)
+}
+
+// normalizeMoveAlert validates window and cooldown of move alert
+// Cooldown defaults to window so alert fires at most once per measured period
+func normalizeMoveAlert(alert *entities.Alert) error {
+	window := alert.Window()
+	if window < MinAlertWindow || window > MaxAlertWindow {
+		return &entities.ValidationError{Field: "window", Message: fmt.Sprintf("window must be between %s and %s", MinAlertWindow, MaxAlertWindow)}
+	}
+	if alert.CooldownSeconds == 0 {
+		alert.CooldownSeconds = alert.WindowSeconds
+	}
+	if alert.Cooldown() < MinAlertWindow || alert.Cooldown() > MaxAlertWindow {
+		return &entities.ValidationError{Field: "cooldown", Message: fmt.Sprintf("cooldown must be between %s and %s", MinAlertWindow, MaxAlertWindow)}
+	}
+	return nil
+}
+
+// GetUserAlerts retrieves active alerts of user
+func (uc *AlertUseCase) GetUserAlerts(ctx context.Context, userID int64) ([]*entities.Alert, error) {
+	return uc.alertRepo.GetUserAlerts(ctx, userID)
+}
+
+// DeleteAlert removes alert owned by user
+func (uc *AlertUseCase) DeleteAlert(ctx context.Context, userID, alertID int64) error {
+	return uc.alertRepo.DeleteAlert(ctx, userID, alertID)
+}
+
+// Evaluate fires active alerts whose condition holds for saved price
+// Move alerts compare price with last observation made before their window started
+func (uc *AlertUseCase) Evaluate(ctx context.Context, tick *entities.PriceTick) error {
+	alerts, err := uc.alertRepo.GetActiveAlerts(ctx, tick.CurrencyID, tick.Quote)
+	if err != nil {
+		return err
+	}
+
+	bases := make(map[int64]*entities.PriceTick)
+	for _, alert := range alerts {
+		event := entities.AlertTriggered{Alert: alert, Tick: tick}
+
+		if !alert.Condition.IsMove() {
+			if !alert.Condition.Met(tick.Price, alert.Threshold) {
+				continue
+			}
+			marked, err := uc.alertRepo.MarkTriggered(ctx, alert.ID, tick.ObservedAt)
+			if err != nil {
+				return err
+			}
+			if !marked {
+				continue
+			}
+			triggeredAt := tick.ObservedAt
+			alert.TriggeredAt = &triggeredAt
+			// Threshold alert fires once, so undelivered notification must leave it active to fire again
+			if err := uc.publish(ctx, event); err != nil {
+				alert.TriggeredAt = nil
+				if unmarkErr := uc.alertRepo.UnmarkTriggered(context.WithoutCancel(ctx), alert.ID, tick.ObservedAt); unmarkErr != nil {
+					return errors.Join(err, unmarkErr)
+				}
+				return err
+			}
+			continue
+		}
+
+		base, ok := bases[alert.WindowSeconds]
+		if !ok {
+			base, err = uc.currencyRepo.GetPriceAt(ctx, tick.CurrencyID, tick.Quote, tick.ObservedAt.Add(-alert.Window()))
+			if err != nil && !errors.Is(err, entities.ErrCurrencyNotFound) {
+				return err
+			}
+			bases[alert.WindowSeconds] = base
+		}
+		// History shorter than window cannot tell change over it
+		if base == nil || base.Price <= 0 {
+			continue
+		}
+
+		event.Base = base
+		event.Change = entities.ChangePercent(tick.Price, base.Price)
+		if !alert.Condition.Met(event.Change, alert.Threshold) {
+			continue
+		}
+		marked, err := uc.alertRepo.MarkMoveFired(ctx, alert.ID, tick.ObservedAt)
+		if err != nil {
+			return err
+		}
+		if !marked {
+			continue
+		}
+		previous := alert.LastFiredAt
+		firedAt := tick.ObservedAt
+		alert.LastFiredAt = &firedAt
+		// Undelivered notification must not start cooldown
+		if err := uc.publish(ctx, event); err != nil {
+			alert.LastFiredAt = previous
+			if unmarkErr := uc.alertRepo.UnmarkMoveFired(context.WithoutCancel(ctx), alert.ID, tick.ObservedAt, previous); unmarkErr != nil {
+				return errors.Join(err, unmarkErr)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// publish hands fired alert to notification consumers waiting at most AlertPublishTimeout
+func (uc *AlertUseCase) publish(ctx context.Context, event entities.AlertTriggered) error {
+	ctx, cancel := context.WithTimeout(ctx, AlertPublishTimeout)
+	defer cancel()
+
+	if err := uc.events.PublishWait(ctx, event); err != nil {
+		return fmt.Errorf("publish alert %d: %w", event.Alert.ID, err)
+	}
+	return nil
+}
+
+// Run evaluates alerts against every saved price until context is cancelled
+// Returns when price channel is closed
+func (uc *AlertUseCase) Run(ctx context.Context, prices <-chan entities.PriceUpdated) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-prices:
+			if !ok {
+				return
+			}
+			if err := uc.Evaluate(ctx, event.Tick); err != nil {
+				uc.logger.Error("Failed to evaluate alerts", "currency", event.Tick.CurrencyID, "quote", event.Tick.Quote, "error", err)
+			}
+		}
+	}
+}
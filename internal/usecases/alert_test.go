@@ -0,0 +1,227 @@
+package usecase
+
+import (
+	"context"
+	"currencyhub/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+type MockAlertRepository struct {
+	mock.Mock
+}
+
+func (m *MockAlertRepository) CreateAlert(ctx context.Context, alert *entities.Alert) error {
+	args := m.Called(ctx, alert)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) GetUserAlerts(ctx context.Context, userID int64) ([]*entities.Alert, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Alert), args.Error(1)
+}
+
+func (m *MockAlertRepository) CountUserAlerts(ctx context.Context, userID int64) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockAlertRepository) DeleteAlert(ctx context.Context, userID, alertID int64) error {
+	args := m.Called(ctx, userID, alertID)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) GetActiveAlerts(ctx context.Context, currencyID, quote string) ([]*entities.Alert, error) {
+	args := m.Called(ctx, currencyID, quote)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Alert), args.Error(1)
+}
+
+func (m *MockAlertRepository) MarkTriggered(ctx context.Context, alertID int64, at time.Time) (bool, error) {
+	args := m.Called(ctx, alertID, at)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAlertRepository) UnmarkTriggered(ctx context.Context, alertID int64, at time.Time) error {
+	args := m.Called(ctx, alertID, at)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) MarkMoveFired(ctx context.Context, alertID int64, at time.Time) (bool, error) {
+	args := m.Called(ctx, alertID, at)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAlertRepository) UnmarkMoveFired(ctx context.Context, alertID int64, at time.Time, previous *time.Time) error {
+	args := m.Called(ctx, alertID, at, previous)
+	return args.Error(0)
+}
+
+func newTestAlertUseCase() (*AlertUseCase, *MockAlertRepository, *MockCurrencyRepository, *MockEventPublisher) {
+	alertRepo := new(MockAlertRepository)
+	currencyRepo := new(MockCurrencyRepository)
+	events := new(MockEventPublisher)
+	return NewAlertUseCase(alertRepo, currencyRepo, testQuotes, events, slog.Default()), alertRepo, currencyRepo, events
+}
+
+func TestAlertUseCase_CreateAlert(t *testing.T) {
+	useCase, alertRepo, currencyRepo, _ := newTestAlertUseCase()
+	alert := &entities.Alert{UserID: 1, CurrencyID: "bitcoin", Quote: "USD", Condition: entities.AlertAbove, Threshold: 70000}
+
+	alertRepo.On("CountUserAlerts", mock.Anything, int64(1)).Return(2, nil)
+	currencyRepo.On("GetLatestByCurrency", mock.Anything, "bitcoin", "usd").Return(&entities.CurrencyRate{CurrentPrice: 65000}, nil)
+	alertRepo.On("CreateAlert", mock.Anything, alert).Return(nil)
+
+	err := useCase.CreateAlert(context.Background(), alert)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "usd", alert.Quote)
+	alertRepo.AssertExpectations(t)
+}
+
+func TestAlertUseCase_CreateAlert_Rejected(t *testing.T) {
+	useCase, alertRepo, currencyRepo, _ := newTestAlertUseCase()
+
+	alertRepo.On("CountUserAlerts", mock.Anything, int64(1)).Return(0, nil)
+	alertRepo.On("CountUserAlerts", mock.Anything, int64(2)).Return(MaxUserAlerts, nil)
+	currencyRepo.On("GetLatestByCurrency", mock.Anything, "bitcoin", "usd").Return(&entities.CurrencyRate{CurrentPrice: 72000}, nil)
+
+	tests := []struct {
+		name  string
+		alert *entities.Alert
+		err   error
+	}{
+		{"threshold", &entities.Alert{UserID: 1, CurrencyID: "bitcoin", Quote: "usd", Condition: entities.AlertAbove, Threshold: -1}, entities.ErrInvalidArgument},
+		{"condition", &entities.Alert{UserID: 1, CurrencyID: "bitcoin", Quote: "usd", Condition: "sideways", Threshold: 1}, entities.ErrInvalidArgument},
+		{"quote", &entities.Alert{UserID: 1, CurrencyID: "bitcoin", Quote: "jpy", Condition: entities.AlertAbove, Threshold: 1}, entities.ErrUnsupportedQuote},
+		{"limit", &entities.Alert{UserID: 2, CurrencyID: "bitcoin", Quote: "usd", Condition: entities.AlertAbove, Threshold: 80000}, entities.ErrTooManyAlerts},
+		{"already met", &entities.Alert{UserID: 1, CurrencyID: "bitcoin", Quote: "usd", Condition: entities.AlertAbove, Threshold: 70000}, entities.ErrAlertConditionMet},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := useCase.CreateAlert(context.Background(), tt.alert)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+	alertRepo.AssertNotCalled(t, "CreateAlert", mock.Anything, mock.Anything)
+}
+
+func TestAlertUseCase_Evaluate(t *testing.T) {
+	useCase, alertRepo, _, events := newTestAlertUseCase()
+	observedAt := time.Date(2025, 1, 14, 12, 0, 0, 0, time.UTC)
+	tick := &entities.PriceTick{CurrencyID: "bitcoin", Quote: "usd", Price: 71000, ObservedAt: observedAt}
+
+	above := &entities.Alert{ID: 1, CurrencyID: "bitcoin", Quote: "usd", Condition: entities.AlertAbove, Threshold: 70000}
+	below := &entities.Alert{ID: 2, CurrencyID: "bitcoin", Quote: "usd", Condition: entities.AlertBelow, Threshold: 60000}
+	fired := &entities.Alert{ID: 3, CurrencyID: "bitcoin", Quote: "usd", Condition: entities.AlertAbove, Threshold: 65000}
+
+	alertRepo.On("GetActiveAlerts", mock.Anything, "bitcoin", "usd").Return([]*entities.Alert{above, below, fired}, nil)
+	alertRepo.On("MarkTriggered", mock.Anything, int64(1), observedAt).Return(true, nil)
+	alertRepo.On("MarkTriggered", mock.Anything, int64(3), observedAt).Return(false, nil)
+	events.On("PublishWait", mock.Anything, entities.AlertTriggered{Alert: above, Tick: tick}).Return(nil)
+
+	err := useCase.Evaluate(context.Background(), tick)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &observedAt, above.TriggeredAt)
+	assert.Nil(t, below.TriggeredAt)
+	events.AssertNumberOfCalls(t, "PublishWait", 1)
+	alertRepo.AssertExpectations(t)
+}
+
+func TestAlertUseCase_Evaluate_PublishFailed(t *testing.T) {
+	useCase, alertRepo, _, events := newTestAlertUseCase()
+	observedAt := time.Date(2025, 1, 14, 12, 0, 0, 0, time.UTC)
+	tick := &entities.PriceTick{CurrencyID: "bitcoin", Quote: "usd", Price: 71000, ObservedAt: observedAt}
+	above := &entities.Alert{ID: 1, CurrencyID: "bitcoin", Quote: "usd", Condition: entities.AlertAbove, Threshold: 70000}
+
+	alertRepo.On("GetActiveAlerts", mock.Anything, "bitcoin", "usd").Return([]*entities.Alert{above}, nil)
+	alertRepo.On("MarkTriggered", mock.Anything, int64(1), observedAt).Return(true, nil)
+	alertRepo.On("UnmarkTriggered", mock.Anything, int64(1), observedAt).Return(nil)
+	events.On("PublishWait", mock.Anything, mock.Anything).Return(context.Canceled)
+
+	err := useCase.Evaluate(context.Background(), tick)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, above.TriggeredAt)
+	alertRepo.AssertExpectations(t)
+	events.AssertNotCalled(t, "Publish", mock.Anything)
+}
+
+func TestAlertUseCase_CreateAlert_Move(t *testing.T) {
+	useCase, alertRepo, currencyRepo, _ := newTestAlertUseCase()
+	alert := &entities.Alert{UserID: 1, CurrencyID: "solana", Quote: "usd", Condition: entities.AlertMove, Threshold: 5, WindowSeconds: 3600}
+
+	alertRepo.On("CountUserAlerts", mock.Anything, int64(1)).Return(0, nil)
+	alertRepo.On("CreateAlert", mock.Anything, alert).Return(nil)
+
+	err := useCase.CreateAlert(context.Background(), alert)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3600), alert.CooldownSeconds, "cooldown defaults to window")
+	currencyRepo.AssertNotCalled(t, "GetLatestByCurrency", mock.Anything, mock.Anything, mock.Anything)
+
+	tooShort := &entities.Alert{UserID: 1, CurrencyID: "solana", Quote: "usd", Condition: entities.AlertMove, Threshold: 5, WindowSeconds: 10}
+	assert.ErrorIs(t, useCase.CreateAlert(context.Background(), tooShort), entities.ErrInvalidArgument)
+}
+
+func TestAlertUseCase_Evaluate_Move(t *testing.T) {
+	useCase, alertRepo, currencyRepo, events := newTestAlertUseCase()
+	observedAt := time.Date(2025, 1, 14, 12, 0, 0, 0, time.UTC)
+	tick := &entities.PriceTick{CurrencyID: "solana", Quote: "usd", Price: 106, ObservedAt: observedAt}
+	base := &entities.PriceTick{CurrencyID: "solana", Quote: "usd", Price: 100, ObservedAt: observedAt.Add(-time.Hour)}
+
+	rise := &entities.Alert{ID: 1, Condition: entities.AlertRise, Threshold: 5, WindowSeconds: 3600, CooldownSeconds: 3600}
+	fall := &entities.Alert{ID: 2, Condition: entities.AlertFall, Threshold: 5, WindowSeconds: 3600, CooldownSeconds: 3600}
+	cooling := &entities.Alert{ID: 3, Condition: entities.AlertMove, Threshold: 5, WindowSeconds: 3600, CooldownSeconds: 3600}
+	young := &entities.Alert{ID: 4, Condition: entities.AlertMove, Threshold: 1, WindowSeconds: 86400, CooldownSeconds: 86400}
+
+	alertRepo.On("GetActiveAlerts", mock.Anything, "solana", "usd").Return([]*entities.Alert{rise, fall, cooling, young}, nil)
+	currencyRepo.On("GetPriceAt", mock.Anything, "solana", "usd", observedAt.Add(-time.Hour)).Return(base, nil).Once()
+	currencyRepo.On("GetPriceAt", mock.Anything, "solana", "usd", observedAt.Add(-24*time.Hour)).Return(nil, entities.ErrCurrencyNotFound)
+	alertRepo.On("MarkMoveFired", mock.Anything, int64(1), observedAt).Return(true, nil)
+	alertRepo.On("MarkMoveFired", mock.Anything, int64(3), observedAt).Return(false, nil)
+	events.On("PublishWait", mock.Anything, mock.Anything).Return(nil)
+
+	err := useCase.Evaluate(context.Background(), tick)
+
+	assert.NoError(t, err)
+	events.AssertNumberOfCalls(t, "PublishWait", 1)
+	fired := events.Calls[0].Arguments.Get(1).(entities.AlertTriggered)
+	assert.Same(t, rise, fired.Alert)
+	assert.Same(t, base, fired.Base)
+	assert.InDelta(t, 6.0, fired.Change, 1e-9)
+	assert.Equal(t, &observedAt, rise.LastFiredAt)
+	assert.Nil(t, fall.LastFiredAt)
+	alertRepo.AssertExpectations(t)
+	currencyRepo.AssertExpectations(t)
+}
+
+func TestAlertUseCase_Evaluate_MovePublishFailed(t *testing.T) {
+	useCase, alertRepo, currencyRepo, events := newTestAlertUseCase()
+	observedAt := time.Date(2025, 1, 14, 12, 0, 0, 0, time.UTC)
+	previous := observedAt.Add(-2 * time.Hour)
+	tick := &entities.PriceTick{CurrencyID: "solana", Quote: "usd", Price: 106, ObservedAt: observedAt}
+	base := &entities.PriceTick{CurrencyID: "solana", Quote: "usd", Price: 100, ObservedAt: observedAt.Add(-time.Hour)}
+	rise := &entities.Alert{ID: 1, Condition: entities.AlertRise, Threshold: 5, WindowSeconds: 3600, CooldownSeconds: 3600, LastFiredAt: &previous}
+
+	alertRepo.On("GetActiveAlerts", mock.Anything, "solana", "usd").Return([]*entities.Alert{rise}, nil)
+	currencyRepo.On("GetPriceAt", mock.Anything, "solana", "usd", observedAt.Add(-time.Hour)).Return(base, nil)
+	alertRepo.On("MarkMoveFired", mock.Anything, int64(1), observedAt).Return(true, nil)
+	alertRepo.On("UnmarkMoveFired", mock.Anything, int64(1), observedAt, &previous).Return(nil)
+	events.On("PublishWait", mock.Anything, mock.Anything).Return(context.DeadlineExceeded)
+
+	err := useCase.Evaluate(context.Background(), tick)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, &previous, rise.LastFiredAt)
+	alertRepo.AssertExpectations(t)
+}
@@ -0,0 +1,105 @@
+// Coin catalog use cases.
+// Contains:
+// - Supported coin listing
+// - Runtime coin enabling and disabling
+// - Coin validation rules
+package usecase
+
+import (
+	"context"
+	"currencyhub/internal/entities"
+	"currencyhub/internal/interfaces"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// coinIDPattern matches CoinGecko coin slugs
+var coinIDPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// CoinUseCase provides business logic operations for coin catalog
+// Catalog changes take effect on next fetch cycle without redeploy
+type CoinUseCase struct {
+	coinRepo interfaces.CoinRepository
+	events   interfaces.EventPublisher
+}
+
+// NewCoinUseCase creates a new instance of CoinUseCase
+// with the provided coin repository and event publisher dependencies
+func NewCoinUseCase(coinRepo interfaces.CoinRepository, events interfaces.EventPublisher) *CoinUseCase {
+	return &CoinUseCase{coinRepo: coinRepo, events: events}
+}
+
+// GetCoins retrieves tracked coins from catalog
+func (uc *CoinUseCase) GetCoins(ctx context.Context) ([]*entities.Coin, error) {
+	return uc.coinRepo.GetCoins(ctx, true)
+}
+
+// GetAllCoins retrieves catalog coins including disabled ones
+func (uc *CoinUseCase) GetAllCoins(ctx context.Context) ([]*entities.Coin, error) {
+	return uc.coinRepo.GetCoins(ctx, false)
+}
+
+// CoinIDs retrieves identifiers of tracked coins
+// Used by fetcher to decide which prices to request
+func (uc *CoinUseCase) CoinIDs(ctx context.Context) ([]string, error) {
+	coins, err := uc.coinRepo.GetCoins(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(coins))
+	for _, coin := range coins {
+		ids = append(ids, coin.ID)
+	}
+	return ids, nil
+}
+
+// AddCoin validates and adds tracked coin to catalog
+// Identifier must be CoinGecko slug, symbol is stored uppercase
+func (uc *CoinUseCase) AddCoin(ctx context.Context, coin *entities.Coin) error {
+	coin.ID = strings.ToLower(strings.TrimSpace(coin.ID))
+	coin.Symbol = strings.ToUpper(strings.TrimSpace(coin.Symbol))
+	coin.Name = strings.TrimSpace(coin.Name)
+
+	if !coinIDPattern.MatchString(coin.ID) {
+		return &entities.ValidationError{Field: "id", Message: fmt.Sprintf("invalid coin id: %q", coin.ID)}
+	}
+	if coin.Symbol == "" {
+		return &entities.ValidationError{Field: "symbol", Message: "coin symbol is required"}
+	}
+	if coin.Name == "" {
+		coin.Name = coin.ID
+	}
+	coin.Enabled = true
+
+	if err := uc.coinRepo.AddCoin(ctx, coin); err != nil {
+		return err
+	}
+	uc.events.Publish(entities.CoinAdded{Coin: coin})
+	return nil
+}
+
+// EnableCoin resumes tracking of catalog coin
+func (uc *CoinUseCase) EnableCoin(ctx context.Context, id string) error {
+	return uc.coinRepo.SetCoinEnabled(ctx, id, true)
+}
+
+// DisableCoin stops tracking of catalog coin
+// Stored history is kept and returns when coin is enabled again
+func (uc *CoinUseCase) DisableCoin(ctx context.Context, id string) error {
+	return uc.coinRepo.SetCoinEnabled(ctx, id, false)
+}
+
+// AddAlias adds alternative name coin can be looked up by
+// Alias is normalized the same way as resolved queries
+func (uc *CoinUseCase) AddAlias(ctx context.Context, alias, coinID string) error {
+	key := normalizeCoinKey(alias)
+	if key == "" {
+		return &entities.ValidationError{Field: "alias", Message: "alias is required"}
+	}
+	if _, err := uc.coinRepo.GetCoin(ctx, coinID); err != nil {
+		return err
+	}
+	return uc.coinRepo.AddAlias(ctx, key, coinID)
+}
@@ -0,0 +1,204 @@
+package usecase
+
+import (
+	"context"
+	"currencyhub/internal/entities"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+type MockCoinRepository struct {
+	mock.Mock
+}
+
+func (m *MockCoinRepository) GetCoins(ctx context.Context, enabledOnly bool) ([]*entities.Coin, error) {
+	args := m.Called(ctx, enabledOnly)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Coin), args.Error(1)
+}
+
+func (m *MockCoinRepository) GetCoin(ctx context.Context, id string) (*entities.Coin, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Coin), args.Error(1)
+}
+
+func (m *MockCoinRepository) AddCoin(ctx context.Context, coin *entities.Coin) error {
+	args := m.Called(ctx, coin)
+	return args.Error(0)
+}
+
+func (m *MockCoinRepository) SetCoinEnabled(ctx context.Context, id string, enabled bool) error {
+	args := m.Called(ctx, id, enabled)
+	return args.Error(0)
+}
+
+func (m *MockCoinRepository) GetAliases(ctx context.Context) (map[string]string, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]string), args.Error(1)
+}
+
+func (m *MockCoinRepository) AddAlias(ctx context.Context, alias, coinID string) error {
+	args := m.Called(ctx, alias, coinID)
+	return args.Error(0)
+}
+
+type MockEventPublisher struct {
+	mock.Mock
+}
+
+func (m *MockEventPublisher) Publish(event any) {
+	m.Called(event)
+}
+
+func (m *MockEventPublisher) PublishWait(ctx context.Context, event any) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func TestCoinUseCase_CoinIDs(t *testing.T) {
+	mockRepo := new(MockCoinRepository)
+	useCase := NewCoinUseCase(mockRepo, new(MockEventPublisher))
+
+	mockRepo.On("GetCoins", mock.Anything, true).Return([]*entities.Coin{
+		{ID: "bitcoin", Symbol: "BTC"},
+		{ID: "ethereum", Symbol: "ETH"},
+	}, nil)
+
+	ids, err := useCase.CoinIDs(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bitcoin", "ethereum"}, ids)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCoinUseCase_CoinIDs_Error(t *testing.T) {
+	mockRepo := new(MockCoinRepository)
+	useCase := NewCoinUseCase(mockRepo, new(MockEventPublisher))
+
+	mockRepo.On("GetCoins", mock.Anything, true).Return(nil, errors.New("database error"))
+
+	ids, err := useCase.CoinIDs(context.Background())
+
+	assert.Error(t, err)
+	assert.Nil(t, ids)
+}
+
+func TestCoinUseCase_AddCoin(t *testing.T) {
+	mockRepo := new(MockCoinRepository)
+	mockEvents := new(MockEventPublisher)
+	useCase := NewCoinUseCase(mockRepo, mockEvents)
+
+	mockRepo.On("AddCoin", mock.Anything, mock.Anything).Return(nil)
+	mockEvents.On("Publish", mock.AnythingOfType("entities.CoinAdded")).Return()
+
+	coin := &entities.Coin{ID: " Pepe ", Symbol: "pepe"}
+	err := useCase.AddCoin(context.Background(), coin)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &entities.Coin{ID: "pepe", Symbol: "PEPE", Name: "pepe", Enabled: true}, coin)
+	mockRepo.AssertExpectations(t)
+	mockEvents.AssertCalled(t, "Publish", entities.CoinAdded{Coin: coin})
+}
+
+func TestCoinUseCase_AddCoin_NoEventOnError(t *testing.T) {
+	mockRepo := new(MockCoinRepository)
+	mockEvents := new(MockEventPublisher)
+	useCase := NewCoinUseCase(mockRepo, mockEvents)
+
+	mockRepo.On("AddCoin", mock.Anything, mock.Anything).Return(errors.New("database error"))
+
+	err := useCase.AddCoin(context.Background(), &entities.Coin{ID: "pepe", Symbol: "PEPE"})
+
+	assert.Error(t, err)
+	mockEvents.AssertNotCalled(t, "Publish", mock.Anything)
+}
+
+func TestCoinUseCase_AddCoin_Invalid(t *testing.T) {
+	mockRepo := new(MockCoinRepository)
+	useCase := NewCoinUseCase(mockRepo, new(MockEventPublisher))
+
+	assert.Error(t, useCase.AddCoin(context.Background(), &entities.Coin{ID: "bad id", Symbol: "BAD"}))
+	assert.Error(t, useCase.AddCoin(context.Background(), &entities.Coin{ID: "bitcoin"}))
+	mockRepo.AssertNotCalled(t, "AddCoin", mock.Anything, mock.Anything)
+}
+
+func newResolverUseCase() *CoinUseCase {
+	mockRepo := new(MockCoinRepository)
+	mockRepo.On("GetCoins", mock.Anything, true).Return([]*entities.Coin{
+		{ID: "bitcoin", Symbol: "BTC", Name: "Bitcoin"},
+		{ID: "bitcoin-cash", Symbol: "BCH", Name: "Bitcoin Cash"},
+		{ID: "avalanche-2", Symbol: "AVAX", Name: "Avalanche"},
+		{ID: "polygon-pos", Symbol: "POL", Name: "Polygon"},
+	}, nil)
+	mockRepo.On("GetAliases", mock.Anything).Return(map[string]string{"matic": "polygon-pos", "xbt": "bitcoin"}, nil)
+	return NewCoinUseCase(mockRepo, new(MockEventPublisher))
+}
+
+func TestCoinUseCase_Resolve(t *testing.T) {
+	useCase := newResolverUseCase()
+
+	tests := map[string]string{
+		"bitcoin":      "bitcoin",
+		"BTC":          "bitcoin",
+		"Bitcoin Cash": "bitcoin-cash",
+		"avalanche":    "avalanche-2",
+		"avax":         "avalanche-2",
+		"MATIC":        "polygon-pos",
+		"xbt":          "bitcoin",
+	}
+	for query, expected := range tests {
+		coin, err := useCase.Resolve(context.Background(), query)
+		if assert.NoError(t, err, query) {
+			assert.Equal(t, expected, coin.ID, query)
+		}
+	}
+}
+
+func TestCoinUseCase_Resolve_Suggestions(t *testing.T) {
+	useCase := newResolverUseCase()
+
+	_, err := useCase.Resolve(context.Background(), "bitconi")
+
+	var notFound *entities.CoinNotFoundError
+	if assert.ErrorAs(t, err, &notFound) {
+		assert.Equal(t, []string{"bitcoin"}, notFound.Suggestions)
+	}
+	assert.ErrorIs(t, err, entities.ErrCoinNotFound)
+
+	_, err = useCase.Resolve(context.Background(), "zzzzzz")
+	if assert.ErrorAs(t, err, &notFound) {
+		assert.Empty(t, notFound.Suggestions)
+	}
+}
+
+func TestCoinUseCase_Search(t *testing.T) {
+	useCase := newResolverUseCase()
+
+	coins, err := useCase.Search(context.Background(), "bitc", 10)
+	assert.NoError(t, err)
+	ids := make([]string, 0, len(coins))
+	for _, coin := range coins {
+		ids = append(ids, coin.ID)
+	}
+	assert.Equal(t, []string{"bitcoin", "bitcoin-cash"}, ids)
+
+	coins, err = useCase.Search(context.Background(), "btc", 10)
+	assert.NoError(t, err)
+	if assert.NotEmpty(t, coins) {
+		assert.Equal(t, "bitcoin", coins[0].ID)
+	}
+
+	coins, err = useCase.Search(context.Background(), "", 2)
+	assert.NoError(t, err)
+	assert.Len(t, coins, 2)
+}
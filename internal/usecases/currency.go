@@ -9,6 +9,28 @@ import (
 	"context"
 	"currencyhub/internal/entities"
 	"currencyhub/internal/interfaces"
+	"fmt"
+	"time"
+)
+
+// MaxCandles limits number of candles returned by single query
+// DefaultCandles is used when range start is not specified
+const (
+	MaxCandles     = 1000
+	DefaultCandles = 100
+)
+
+// MaxHistoryPoints limits number of points in downsampled price history
+// DefaultHistoryRange is used when history range start is not specified
+const (
+	MaxHistoryPoints    = 500
+	DefaultHistoryRange = 24 * time.Hour
+)
+
+// DefaultHistoryPage and MaxHistoryPage bound page size of raw price history
+const (
+	DefaultHistoryPage = 500
+	MaxHistoryPage     = 1000
 )
 
 // CurrencyUseCase provides business logic operations for currency data
@@ -25,23 +47,171 @@ func NewCurrencyUseCase(currencyRepo interfaces.CurrencyRepository) *CurrencyUse
 
 // GetRates retrieves latest rates for all available cryptocurrencies
 // Returns a slice of CurrencyRate entities or error if operation fails
-func (uc *CurrencyUseCase) GetRates(ctx context.Context) ([]*entities.CurrencyRate, error) {
-	return uc.currencyRepo.GetRates(ctx)
+func (uc *CurrencyUseCase) GetRates(ctx context.Context, quote string) ([]*entities.CurrencyRate, error) {
+	return uc.currencyRepo.GetRates(ctx, quote)
+}
+
+// GetWatchlistRates retrieves latest rates of coins from watchlist
+// Empty watchlist means all coins, rates keep GetRates order
+func (uc *CurrencyUseCase) GetWatchlistRates(ctx context.Context, quote string, watchlist []string) ([]*entities.CurrencyRate, error) {
+	rates, err := uc.currencyRepo.GetRates(ctx, quote)
+	if err != nil {
+		return nil, err
+	}
+	return entities.FilterRates(rates, watchlist), nil
 }
 
 // GetLatestByCurrency retrieves most recent rate for specific cryptocurrency
-func (uc *CurrencyUseCase) GetLatestByCurrency(ctx context.Context, currencyID string) (*entities.CurrencyRate, error) {
-	return uc.currencyRepo.GetLatestByCurrency(ctx, currencyID)
+func (uc *CurrencyUseCase) GetLatestByCurrency(ctx context.Context, currencyID, quote string) (*entities.CurrencyRate, error) {
+	return uc.currencyRepo.GetLatestByCurrency(ctx, currencyID, quote)
 }
 
-// CheckList validates currency exists in supported list
+// CheckList validates currency is enabled in coin catalog
 // Returns true if currency is supported by application
-func (uc *CurrencyUseCase) CheckList(coin string) bool {
-	return uc.currencyRepo.CheckList(coin)
+func (uc *CurrencyUseCase) CheckList(ctx context.Context, coin string) (bool, error) {
+	return uc.currencyRepo.CheckList(ctx, coin)
 }
 
-// SavePrice updates currency data in database with new price information
+// SavePrice appends price observation to history and refreshes snapshot
 // Maintains hourly and daily statistics for price tracking
-func (uc *CurrencyUseCase) SavePrice(ctx context.Context, coinID string, price float64) error {
-	return uc.currencyRepo.SavePrice(ctx, coinID, price)
+func (uc *CurrencyUseCase) SavePrice(ctx context.Context, tick *entities.PriceTick) error {
+	return uc.currencyRepo.SavePrice(ctx, tick)
+}
+
+// MarkStale flags currencies that received no price in update cycle
+func (uc *CurrencyUseCase) MarkStale(ctx context.Context, quote string, currencyIDs []string) error {
+	return uc.currencyRepo.MarkStale(ctx, quote, currencyIDs)
+}
+
+// GetPriceAt retrieves price of cryptocurrency observed at given moment
+// Returns the last observation made at or before that time
+func (uc *CurrencyUseCase) GetPriceAt(ctx context.Context, currencyID, quote string, at time.Time) (*entities.PriceTick, error) {
+	return uc.currencyRepo.GetPriceAt(ctx, currencyID, quote, at)
+}
+
+// GetTicks retrieves price observations of cryptocurrency within time range
+// Returns error if range end precedes its start
+func (uc *CurrencyUseCase) GetTicks(ctx context.Context, currencyID, quote string, from, to time.Time) ([]*entities.PriceTick, error) {
+	if to.Before(from) {
+		return nil, &entities.ValidationError{
+			Field:   "to",
+			Message: fmt.Sprintf("invalid time range: %s is before %s", to.Format(time.RFC3339), from.Format(time.RFC3339)),
+		}
+	}
+	return uc.currencyRepo.GetTicks(ctx, currencyID, quote, from, to)
+}
+
+// GetLatestQuotes retrieves per-source quotes behind latest cryptocurrency price
+// Shows which sources were used and which were rejected as outliers
+func (uc *CurrencyUseCase) GetLatestQuotes(ctx context.Context, currencyID, quote string) ([]*entities.PriceQuote, error) {
+	return uc.currencyRepo.GetLatestQuotes(ctx, currencyID, quote)
+}
+
+// GetCandles retrieves OHLC candles of cryptocurrency for given resolution
+// Zero range bounds default to now and DefaultCandles buckets back
+func (uc *CurrencyUseCase) GetCandles(ctx context.Context, currencyID, quote string, interval entities.CandleInterval, from, to time.Time) ([]*entities.Candle, error) {
+	if !interval.IsValid() {
+		return nil, &entities.ValidationError{Field: "interval", Message: fmt.Sprintf("unsupported candle interval: %s", interval)}
+	}
+
+	if to.IsZero() {
+		to = time.Now().UTC()
+	}
+	if from.IsZero() {
+		from = to.Add(-DefaultCandles * interval.Duration())
+	}
+	if to.Before(from) {
+		return nil, &entities.ValidationError{
+			Field:   "to",
+			Message: fmt.Sprintf("invalid time range: %s is before %s", to.Format(time.RFC3339), from.Format(time.RFC3339)),
+		}
+	}
+	if to.Sub(from)/interval.Duration() > MaxCandles {
+		return nil, &entities.ValidationError{
+			Field:   "from",
+			Message: fmt.Sprintf("time range too large: at most %d %s candles allowed", MaxCandles, interval),
+		}
+	}
+
+	return uc.currencyRepo.GetCandles(ctx, currencyID, quote, interval, from, to)
+}
+
+// GetHistory retrieves price history of cryptocurrency downsampled into buckets
+// Step grows so that range fits into MaxHistoryPoints, effective step is returned
+func (uc *CurrencyUseCase) GetHistory(ctx context.Context, currencyID, quote string, from, to time.Time, step time.Duration) ([]*entities.HistoryPoint, time.Duration, error) {
+	if step < 0 {
+		return nil, 0, &entities.ValidationError{Field: "step", Message: fmt.Sprintf("step must be positive: %s", step)}
+	}
+	from, to, err := historyRange(from, to)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	minStep := to.Sub(from) / MaxHistoryPoints
+	if to.Sub(from)%MaxHistoryPoints != 0 {
+		minStep++
+	}
+	step = max(step, minStep, time.Second)
+	if remainder := step % time.Second; remainder != 0 {
+		step += time.Second - remainder
+	}
+
+	points, err := uc.currencyRepo.GetHistory(ctx, currencyID, quote, from, to, step)
+	if err != nil {
+		return nil, 0, err
+	}
+	return points, step, nil
+}
+
+// GetHistoryPage retrieves page of raw price observations of cryptocurrency
+// Empty cursor starts at range beginning, zero limit means DefaultHistoryPage
+func (uc *CurrencyUseCase) GetHistoryPage(ctx context.Context, currencyID, quote string, from, to time.Time, cursor string, limit int) (*entities.TickPage, error) {
+	if limit == 0 {
+		limit = DefaultHistoryPage
+	}
+	if limit < 0 || limit > MaxHistoryPage {
+		return nil, &entities.ValidationError{Field: "limit", Message: fmt.Sprintf("limit must be between 1 and %d", MaxHistoryPage)}
+	}
+	from, to, err := historyRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	after := entities.TickCursor{ObservedAt: from}
+	if cursor != "" {
+		if after, err = entities.ParseTickCursor(cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	ticks, err := uc.currencyRepo.GetTicksAfter(ctx, currencyID, quote, after, to, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &entities.TickPage{Ticks: ticks}
+	if len(ticks) > limit {
+		page.Ticks = ticks[:limit]
+		last := page.Ticks[limit-1]
+		page.NextCursor = entities.TickCursor{ObservedAt: last.ObservedAt, ID: last.ID}.String()
+	}
+	return page, nil
+}
+
+// historyRange applies default price history range bounds
+// Zero end defaults to now and zero start to DefaultHistoryRange before end
+func historyRange(from, to time.Time) (time.Time, time.Time, error) {
+	if to.IsZero() {
+		to = time.Now().UTC()
+	}
+	if from.IsZero() {
+		from = to.Add(-DefaultHistoryRange)
+	}
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, &entities.ValidationError{
+			Field:   "to",
+			Message: fmt.Sprintf("invalid time range: %s is before %s", to.Format(time.RFC3339), from.Format(time.RFC3339)),
+		}
+	}
+	return from, to, nil
 }
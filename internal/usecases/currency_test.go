@@ -7,22 +7,23 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"testing"
+	"time"
 )
 
 type MockCurrencyRepository struct {
 	mock.Mock
 }
 
-func (m *MockCurrencyRepository) GetLatestByCurrency(ctx context.Context, currencyID string) (*entities.CurrencyRate, error) {
-	args := m.Called(ctx, currencyID)
+func (m *MockCurrencyRepository) GetLatestByCurrency(ctx context.Context, currencyID, quote string) (*entities.CurrencyRate, error) {
+	args := m.Called(ctx, currencyID, quote)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*entities.CurrencyRate), args.Error(1)
 }
 
-func (m *MockCurrencyRepository) GetRates(ctx context.Context) ([]*entities.CurrencyRate, error) {
-	args := m.Called(ctx)
+func (m *MockCurrencyRepository) GetRates(ctx context.Context, quote string) ([]*entities.CurrencyRate, error) {
+	args := m.Called(ctx, quote)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -52,13 +53,66 @@ func (m *MockCurrencyRepository) UpdateDailyData(rate *entities.CurrencyRate) er
 	return args.Error(0)
 }
 
-func (m *MockCurrencyRepository) CheckList(coin string) bool {
-	args := m.Called(coin)
-	return args.Bool(0)
+func (m *MockCurrencyRepository) CheckList(ctx context.Context, coin string) (bool, error) {
+	args := m.Called(ctx, coin)
+	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockCurrencyRepository) SavePrice(ctx context.Context, coinID string, price float64) error {
-	args := m.Called(ctx, coinID, price)
+func (m *MockCurrencyRepository) SavePrice(ctx context.Context, tick *entities.PriceTick) error {
+	args := m.Called(ctx, tick)
+	return args.Error(0)
+}
+
+func (m *MockCurrencyRepository) GetPriceAt(ctx context.Context, currencyID, quote string, at time.Time) (*entities.PriceTick, error) {
+	args := m.Called(ctx, currencyID, quote, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PriceTick), args.Error(1)
+}
+
+func (m *MockCurrencyRepository) GetTicks(ctx context.Context, currencyID, quote string, from, to time.Time) ([]*entities.PriceTick, error) {
+	args := m.Called(ctx, currencyID, quote, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.PriceTick), args.Error(1)
+}
+
+func (m *MockCurrencyRepository) GetCandles(ctx context.Context, currencyID, quote string, interval entities.CandleInterval, from, to time.Time) ([]*entities.Candle, error) {
+	args := m.Called(ctx, currencyID, quote, interval, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Candle), args.Error(1)
+}
+
+func (m *MockCurrencyRepository) GetHistory(ctx context.Context, currencyID, quote string, from, to time.Time, step time.Duration) ([]*entities.HistoryPoint, error) {
+	args := m.Called(ctx, currencyID, quote, from, to, step)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.HistoryPoint), args.Error(1)
+}
+
+func (m *MockCurrencyRepository) GetTicksAfter(ctx context.Context, currencyID, quote string, after entities.TickCursor, to time.Time, limit int) ([]*entities.PriceTick, error) {
+	args := m.Called(ctx, currencyID, quote, after, to, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.PriceTick), args.Error(1)
+}
+
+func (m *MockCurrencyRepository) GetLatestQuotes(ctx context.Context, currencyID, quote string) ([]*entities.PriceQuote, error) {
+	args := m.Called(ctx, currencyID, quote)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.PriceQuote), args.Error(1)
+}
+
+func (m *MockCurrencyRepository) MarkStale(ctx context.Context, quote string, currencyIDs []string) error {
+	args := m.Called(ctx, quote, currencyIDs)
 	return args.Error(0)
 }
 
@@ -71,9 +125,9 @@ func TestCurrencyUseCase_GetRates(t *testing.T) {
 		{CurrencyID: "ethereum", CurrentPrice: 3000},
 	}
 
-	mockRepo.On("GetRates", mock.Anything).Return(expectedRates, nil)
+	mockRepo.On("GetRates", mock.Anything, "usd").Return(expectedRates, nil)
 
-	rates, err := useCase.GetRates(context.Background())
+	rates, err := useCase.GetRates(context.Background(), "usd")
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedRates, rates)
@@ -84,28 +138,46 @@ func TestCurrencyUseCase_GetRates_Error(t *testing.T) {
 	mockRepo := new(MockCurrencyRepository)
 	useCase := NewCurrencyUseCase(mockRepo)
 
-	mockRepo.On("GetRates", mock.Anything).Return(nil, errors.New("database error"))
+	mockRepo.On("GetRates", mock.Anything, "usd").Return(nil, errors.New("database error"))
 
-	rates, err := useCase.GetRates(context.Background())
+	rates, err := useCase.GetRates(context.Background(), "usd")
 
 	assert.Error(t, err)
 	assert.Nil(t, rates)
 	mockRepo.AssertExpectations(t)
 }
 
+func TestCurrencyUseCase_GetWatchlistRates(t *testing.T) {
+	mockRepo := new(MockCurrencyRepository)
+	useCase := NewCurrencyUseCase(mockRepo)
+
+	bitcoin := &entities.CurrencyRate{CurrencyID: "bitcoin", CurrentPrice: 50000}
+	ethereum := &entities.CurrencyRate{CurrencyID: "ethereum", CurrentPrice: 3000}
+	solana := &entities.CurrencyRate{CurrencyID: "solana", CurrentPrice: 150}
+	mockRepo.On("GetRates", mock.Anything, "usd").Return([]*entities.CurrencyRate{bitcoin, ethereum, solana}, nil)
+
+	rates, err := useCase.GetWatchlistRates(context.Background(), "usd", []string{"solana", "bitcoin"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*entities.CurrencyRate{bitcoin, solana}, rates)
+
+	rates, err = useCase.GetWatchlistRates(context.Background(), "usd", nil)
+	assert.NoError(t, err)
+	assert.Len(t, rates, 3, "empty watchlist shows all coins")
+}
+
 func TestCurrencyUseCase_GetCurrencyRate(t *testing.T) {
 	mockRepo := new(MockCurrencyRepository)
 	useCase := NewCurrencyUseCase(mockRepo)
 
 	expectedRate := &entities.CurrencyRate{
-		CurrencyID:    "bitcoin",
-		CurrentPrice:  50000,
-		ChangePercent: 2.5,
+		CurrencyID:   "bitcoin",
+		CurrentPrice: 50000,
+		Change1h:     2.5,
 	}
 
-	mockRepo.On("GetLatestByCurrency", mock.Anything, "bitcoin").Return(expectedRate, nil)
+	mockRepo.On("GetLatestByCurrency", mock.Anything, "bitcoin", "usd").Return(expectedRate, nil)
 
-	rate, err := useCase.GetLatestByCurrency(context.Background(), "bitcoin")
+	rate, err := useCase.GetLatestByCurrency(context.Background(), "bitcoin", "usd")
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedRate, rate)
@@ -116,9 +188,9 @@ func TestCurrencyUseCase_GetCurrencyRate_DBError(t *testing.T) {
 	mockRepo := new(MockCurrencyRepository)
 	useCase := NewCurrencyUseCase(mockRepo)
 
-	mockRepo.On("GetLatestByCurrency", mock.Anything, "bitcoin").Return(nil, errors.New("database error"))
+	mockRepo.On("GetLatestByCurrency", mock.Anything, "bitcoin", "usd").Return(nil, errors.New("database error"))
 
-	rate, err := useCase.GetLatestByCurrency(context.Background(), "bitcoin")
+	rate, err := useCase.GetLatestByCurrency(context.Background(), "bitcoin", "usd")
 
 	assert.Error(t, err)
 	assert.Nil(t, rate)
@@ -129,10 +201,170 @@ func TestCurrencyUseCase_CheckList(t *testing.T) {
 	mockRepo := new(MockCurrencyRepository)
 	useCase := NewCurrencyUseCase(mockRepo)
 
-	mockRepo.On("CheckList", "bitcoin").Return(true)
-	mockRepo.On("CheckList", "invalid").Return(false)
+	mockRepo.On("CheckList", mock.Anything, "bitcoin").Return(true, nil)
+	mockRepo.On("CheckList", mock.Anything, "invalid").Return(false, nil)
+	mockRepo.On("CheckList", mock.Anything, "offline").Return(false, errors.New("connection refused"))
+
+	enabled, err := useCase.CheckList(context.Background(), "bitcoin")
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+
+	enabled, err = useCase.CheckList(context.Background(), "invalid")
+	assert.NoError(t, err)
+	assert.False(t, enabled)
+
+	_, err = useCase.CheckList(context.Background(), "offline")
+	assert.Error(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCurrencyUseCase_GetPriceAt(t *testing.T) {
+	mockRepo := new(MockCurrencyRepository)
+	useCase := NewCurrencyUseCase(mockRepo)
+
+	at := time.Date(2025, 1, 14, 14, 5, 0, 0, time.UTC)
+	expectedTick := &entities.PriceTick{CurrencyID: "bitcoin", Price: 97000, ObservedAt: at.Add(-time.Minute)}
+
+	mockRepo.On("GetPriceAt", mock.Anything, "bitcoin", "usd", at).Return(expectedTick, nil)
+
+	tick, err := useCase.GetPriceAt(context.Background(), "bitcoin", "usd", at)
 
-	assert.True(t, useCase.CheckList("bitcoin"))
-	assert.False(t, useCase.CheckList("invalid"))
+	assert.NoError(t, err)
+	assert.Equal(t, expectedTick, tick)
 	mockRepo.AssertExpectations(t)
 }
+
+func TestCurrencyUseCase_GetTicks_InvalidRange(t *testing.T) {
+	mockRepo := new(MockCurrencyRepository)
+	useCase := NewCurrencyUseCase(mockRepo)
+
+	from := time.Date(2025, 1, 14, 0, 0, 0, 0, time.UTC)
+
+	ticks, err := useCase.GetTicks(context.Background(), "bitcoin", "usd", from, from.Add(-time.Hour))
+
+	assert.Error(t, err)
+	assert.Nil(t, ticks)
+	mockRepo.AssertNotCalled(t, "GetTicks", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCurrencyUseCase_GetCandles(t *testing.T) {
+	mockRepo := new(MockCurrencyRepository)
+	useCase := NewCurrencyUseCase(mockRepo)
+
+	to := time.Date(2025, 1, 14, 12, 0, 0, 0, time.UTC)
+	from := to.Add(-6 * time.Hour)
+	expectedCandles := []*entities.Candle{
+		{CurrencyID: "bitcoin", Interval: entities.Interval1h, OpenTime: from, Open: 1, High: 3, Low: 1, Close: 2, Ticks: 12},
+	}
+
+	mockRepo.On("GetCandles", mock.Anything, "bitcoin", "usd", entities.Interval1h, from, to).Return(expectedCandles, nil)
+
+	candles, err := useCase.GetCandles(context.Background(), "bitcoin", "usd", entities.Interval1h, from, to)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedCandles, candles)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCurrencyUseCase_GetCandles_DefaultRange(t *testing.T) {
+	mockRepo := new(MockCurrencyRepository)
+	useCase := NewCurrencyUseCase(mockRepo)
+
+	to := time.Date(2025, 1, 14, 12, 0, 0, 0, time.UTC)
+	from := to.Add(-DefaultCandles * 5 * time.Minute)
+
+	mockRepo.On("GetCandles", mock.Anything, "bitcoin", "usd", entities.Interval5m, from, to).Return([]*entities.Candle{}, nil)
+
+	_, err := useCase.GetCandles(context.Background(), "bitcoin", "usd", entities.Interval5m, time.Time{}, to)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCurrencyUseCase_GetCandles_Invalid(t *testing.T) {
+	mockRepo := new(MockCurrencyRepository)
+	useCase := NewCurrencyUseCase(mockRepo)
+
+	to := time.Date(2025, 1, 14, 12, 0, 0, 0, time.UTC)
+
+	_, err := useCase.GetCandles(context.Background(), "bitcoin", "usd", entities.CandleInterval("2h"), time.Time{}, to)
+	assert.Error(t, err)
+
+	_, err = useCase.GetCandles(context.Background(), "bitcoin", "usd", entities.Interval1m, to.AddDate(0, 0, -30), to)
+	assert.Error(t, err)
+
+	mockRepo.AssertNotCalled(t, "GetCandles", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCurrencyUseCase_GetHistory_Downsampled(t *testing.T) {
+	mockRepo := new(MockCurrencyRepository)
+	useCase := NewCurrencyUseCase(mockRepo)
+
+	to := time.Date(2025, 1, 14, 12, 0, 0, 0, time.UTC)
+	from := to.Add(-7 * 24 * time.Hour)
+	expectedPoints := []*entities.HistoryPoint{{Time: from, Price: 2, MinPrice: 1, MaxPrice: 3, Ticks: 20}}
+
+	// 7 days in 500 points need at least 1209.6s buckets, rounded up to whole seconds
+	mockRepo.On("GetHistory", mock.Anything, "bitcoin", "usd", from, to, 1210*time.Second).Return(expectedPoints, nil)
+
+	points, step, err := useCase.GetHistory(context.Background(), "bitcoin", "usd", from, to, time.Minute)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1210*time.Second, step)
+	assert.Equal(t, expectedPoints, points)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCurrencyUseCase_GetHistory_RequestedStep(t *testing.T) {
+	mockRepo := new(MockCurrencyRepository)
+	useCase := NewCurrencyUseCase(mockRepo)
+
+	to := time.Date(2025, 1, 14, 12, 0, 0, 0, time.UTC)
+	from := to.Add(-time.Hour)
+
+	mockRepo.On("GetHistory", mock.Anything, "bitcoin", "usd", from, to, 5*time.Minute).Return([]*entities.HistoryPoint{}, nil)
+
+	_, step, err := useCase.GetHistory(context.Background(), "bitcoin", "usd", from, to, 5*time.Minute)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, step)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCurrencyUseCase_GetHistoryPage(t *testing.T) {
+	mockRepo := new(MockCurrencyRepository)
+	useCase := NewCurrencyUseCase(mockRepo)
+
+	to := time.Date(2025, 1, 14, 12, 0, 0, 0, time.UTC)
+	from := to.Add(-time.Hour)
+	ticks := []*entities.PriceTick{
+		{ID: 10, Price: 1, ObservedAt: from.Add(time.Minute)},
+		{ID: 11, Price: 2, ObservedAt: from.Add(2 * time.Minute)},
+		{ID: 12, Price: 3, ObservedAt: from.Add(3 * time.Minute)},
+	}
+
+	mockRepo.On("GetTicksAfter", mock.Anything, "bitcoin", "usd", entities.TickCursor{ObservedAt: from}, to, 3).Return(ticks, nil)
+
+	page, err := useCase.GetHistoryPage(context.Background(), "bitcoin", "usd", from, to, "", 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, ticks[:2], page.Ticks)
+	assert.NotEmpty(t, page.NextCursor)
+
+	cursor, err := entities.ParseTickCursor(page.NextCursor)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(11), cursor.ID)
+	assert.True(t, ticks[1].ObservedAt.Equal(cursor.ObservedAt))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCurrencyUseCase_GetHistoryPage_InvalidCursor(t *testing.T) {
+	mockRepo := new(MockCurrencyRepository)
+	useCase := NewCurrencyUseCase(mockRepo)
+
+	page, err := useCase.GetHistoryPage(context.Background(), "bitcoin", "usd", time.Time{}, time.Time{}, "not a cursor", 0)
+
+	assert.ErrorIs(t, err, entities.ErrInvalidArgument)
+	assert.Nil(t, page)
+	mockRepo.AssertNotCalled(t, "GetTicksAfter", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,241 @@
+// Portfolio use cases.
+// Contains:
+// - Trade recording and validation
+// - Position and profit/loss calculation with average cost method
+// - Portfolio API tokens
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"currencyhub/internal/entities"
+	"currencyhub/internal/interfaces"
+	"encoding/hex"
+	"fmt"
+	"math"
+)
+
+// holdingEpsilon absorbs float rounding left after whole holding is sold
+const holdingEpsilon = 1e-9
+
+// PortfolioUseCase provides business logic operations for user portfolios
+// Positions are priced from latest saved rates
+type PortfolioUseCase struct {
+	portfolioRepo interfaces.PortfolioRepository
+	currencyRepo  interfaces.CurrencyRepository
+	quotes        entities.QuoteSet
+}
+
+// NewPortfolioUseCase creates a new instance of PortfolioUseCase
+// with the provided portfolio and currency repository dependencies and fetched quote currencies
+func NewPortfolioUseCase(portfolioRepo interfaces.PortfolioRepository, currencyRepo interfaces.CurrencyRepository, quotes entities.QuoteSet) *PortfolioUseCase {
+	return &PortfolioUseCase{portfolioRepo: portfolioRepo, currencyRepo: currencyRepo, quotes: quotes}
+}
+
+// RecordTrade validates and stores user trade
+// Zero price means latest saved price, sale may not exceed amount held
+// Sale is recorded in quote currency of holding, price given in other quote is converted by latest prices
+func (uc *PortfolioUseCase) RecordTrade(ctx context.Context, transaction *entities.Transaction) error {
+	if transaction.Side != entities.TradeBuy && transaction.Side != entities.TradeSell {
+		return &entities.ValidationError{Field: "side", Message: fmt.Sprintf("invalid trade side: %q", transaction.Side)}
+	}
+	if !isPositive(transaction.Amount) {
+		return &entities.ValidationError{Field: "amount", Message: "amount must be positive number"}
+	}
+	if transaction.Price != 0 && !isPositive(transaction.Price) {
+		return &entities.ValidationError{Field: "price", Message: "price must be positive number"}
+	}
+	quote, ok := uc.quotes.Lookup(transaction.Quote)
+	if !ok {
+		return fmt.Errorf("%w: %s", entities.ErrUnsupportedQuote, transaction.Quote)
+	}
+	transaction.Quote = quote.Code
+
+	if transaction.Side == entities.TradeSell {
+		transactions, err := uc.portfolioRepo.GetTransactions(ctx, transaction.UserID)
+		if err != nil {
+			return err
+		}
+		holding := sellHolding(buildPositions(transactions), transaction)
+		if holding == nil {
+			return fmt.Errorf("%w: less than %g %s held", entities.ErrInsufficientHoldings, transaction.Amount, transaction.CurrencyID)
+		}
+		if holding.Quote != transaction.Quote {
+			if transaction.Price != 0 {
+				price, err := uc.convertPrice(ctx, transaction.CurrencyID, transaction.Price, transaction.Quote, holding.Quote)
+				if err != nil {
+					return err
+				}
+				transaction.Price = price
+			}
+			transaction.Quote = holding.Quote
+		}
+	}
+
+	if transaction.Price == 0 {
+		rate, err := uc.currencyRepo.GetLatestByCurrency(ctx, transaction.CurrencyID, transaction.Quote)
+		if err != nil {
+			return err
+		}
+		transaction.Price = rate.CurrentPrice
+	}
+
+	return uc.portfolioRepo.AddTransaction(ctx, transaction)
+}
+
+// GetPortfolio builds user's positions priced from latest saved rates
+// Totals are calculated separately for every quote currency trades were made in
+func (uc *PortfolioUseCase) GetPortfolio(ctx context.Context, userID int64) (*entities.Portfolio, error) {
+	transactions, err := uc.portfolioRepo.GetTransactions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	portfolio := &entities.Portfolio{Positions: buildPositions(transactions)}
+	prices := make(map[string]map[string]float64)
+	totals := make(map[string]*entities.PortfolioTotal)
+
+	for _, position := range portfolio.Positions {
+		quotePrices, ok := prices[position.Quote]
+		if !ok {
+			rates, err := uc.currencyRepo.GetRates(ctx, position.Quote)
+			if err != nil {
+				return nil, err
+			}
+			quotePrices = make(map[string]float64, len(rates))
+			for _, rate := range rates {
+				quotePrices[rate.CurrencyID] = rate.CurrentPrice
+			}
+			prices[position.Quote] = quotePrices
+		}
+		if price, ok := quotePrices[position.CurrencyID]; ok {
+			position.CurrentPrice = price
+			position.Value = position.Amount * price
+			position.UnrealizedPnL = position.Value - position.CostBasis
+			position.Priced = true
+		}
+
+		total, ok := totals[position.Quote]
+		if !ok {
+			total = &entities.PortfolioTotal{Quote: position.Quote}
+			totals[position.Quote] = total
+			portfolio.Totals = append(portfolio.Totals, total)
+		}
+		total.CostBasis += position.CostBasis
+		total.RealizedPnL += position.RealizedPnL
+		if position.Priced {
+			total.Value += position.Value
+			total.UnrealizedPnL += position.UnrealizedPnL
+		}
+	}
+	return portfolio, nil
+}
+
+// IssueToken creates new portfolio API token of user
+// Only token hash is stored, previous token stops working
+func (uc *PortfolioUseCase) IssueToken(ctx context.Context, userID int64) (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("failed to generate api token: %w", err)
+	}
+	token := hex.EncodeToString(raw)
+
+	if err := uc.portfolioRepo.SaveTokenHash(ctx, userID, hashToken(token)); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// Authenticate returns user owning portfolio API token
+// Returns ErrInvalidToken for empty or unknown tokens
+func (uc *PortfolioUseCase) Authenticate(ctx context.Context, token string) (int64, error) {
+	if token == "" {
+		return 0, entities.ErrInvalidToken
+	}
+	return uc.portfolioRepo.GetUserByTokenHash(ctx, hashToken(token))
+}
+
+// buildPositions replays trades with average cost method
+// Positions keep order of first trade, closed positions are kept for realized P&L
+func buildPositions(transactions []*entities.Transaction) []*entities.Position {
+	var positions []*entities.Position
+	byKey := make(map[string]*entities.Position)
+
+	for _, transaction := range transactions {
+		key := transaction.CurrencyID + "/" + transaction.Quote
+		position, ok := byKey[key]
+		if !ok {
+			position = &entities.Position{CurrencyID: transaction.CurrencyID, Quote: transaction.Quote}
+			byKey[key] = position
+			positions = append(positions, position)
+		}
+
+		switch transaction.Side {
+		case entities.TradeBuy:
+			position.Amount += transaction.Amount
+			position.CostBasis += transaction.Amount * transaction.Price
+		case entities.TradeSell:
+			if position.Amount <= 0 {
+				continue
+			}
+			sold := math.Min(transaction.Amount, position.Amount)
+			averagePrice := position.CostBasis / position.Amount
+			position.RealizedPnL += sold * (transaction.Price - averagePrice)
+			position.CostBasis -= sold * averagePrice
+			position.Amount -= sold
+			if position.Amount < holdingEpsilon {
+				position.Amount, position.CostBasis = 0, 0
+			}
+		}
+	}
+
+	for _, position := range positions {
+		if position.Amount > 0 {
+			position.AveragePrice = position.CostBasis / position.Amount
+		}
+	}
+	return positions
+}
+
+// sellHolding returns position sale is taken from
+// Position in quote of sale is preferred, otherwise first position of coin in other quote holding enough
+func sellHolding(positions []*entities.Position, transaction *entities.Transaction) *entities.Position {
+	var holding *entities.Position
+	for _, position := range positions {
+		if position.CurrencyID != transaction.CurrencyID || transaction.Amount > position.Amount+holdingEpsilon {
+			continue
+		}
+		if position.Quote == transaction.Quote {
+			return position
+		}
+		if holding == nil {
+			holding = position
+		}
+	}
+	return holding
+}
+
+// convertPrice converts coin price between quote currencies by latest saved prices of coin
+func (uc *PortfolioUseCase) convertPrice(ctx context.Context, currencyID string, price float64, from, to string) (float64, error) {
+	fromRate, err := uc.currencyRepo.GetLatestByCurrency(ctx, currencyID, from)
+	if err != nil {
+		return 0, err
+	}
+	toRate, err := uc.currencyRepo.GetLatestByCurrency(ctx, currencyID, to)
+	if err != nil {
+		return 0, err
+	}
+	return price * toRate.CurrentPrice / fromRate.CurrentPrice, nil
+}
+
+// hashToken returns hex encoded SHA-256 of API token
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// isPositive reports whether value is finite number above zero
+func isPositive(value float64) bool {
+	return value > 0 && !math.IsInf(value, 0) && !math.IsNaN(value)
+}
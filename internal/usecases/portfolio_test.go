@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"currencyhub/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+type MockPortfolioRepository struct {
+	mock.Mock
+}
+
+func (m *MockPortfolioRepository) AddTransaction(ctx context.Context, transaction *entities.Transaction) error {
+	args := m.Called(ctx, transaction)
+	return args.Error(0)
+}
+
+func (m *MockPortfolioRepository) GetTransactions(ctx context.Context, userID int64) ([]*entities.Transaction, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Transaction), args.Error(1)
+}
+
+func (m *MockPortfolioRepository) SaveTokenHash(ctx context.Context, userID int64, tokenHash string) error {
+	args := m.Called(ctx, userID, tokenHash)
+	return args.Error(0)
+}
+
+func (m *MockPortfolioRepository) GetUserByTokenHash(ctx context.Context, tokenHash string) (int64, error) {
+	args := m.Called(ctx, tokenHash)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestPortfolioUseCase_RecordTrade_DefaultsToLatestPrice(t *testing.T) {
+	portfolioRepo := new(MockPortfolioRepository)
+	currencyRepo := new(MockCurrencyRepository)
+	useCase := NewPortfolioUseCase(portfolioRepo, currencyRepo, testQuotes)
+	transaction := &entities.Transaction{UserID: 1, CurrencyID: "bitcoin", Quote: "USD", Side: entities.TradeBuy, Amount: 0.5}
+
+	currencyRepo.On("GetLatestByCurrency", mock.Anything, "bitcoin", "usd").Return(&entities.CurrencyRate{CurrentPrice: 62000}, nil)
+	portfolioRepo.On("AddTransaction", mock.Anything, transaction).Return(nil)
+
+	err := useCase.RecordTrade(context.Background(), transaction)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "usd", transaction.Quote)
+	assert.Equal(t, 62000.0, transaction.Price)
+	portfolioRepo.AssertExpectations(t)
+}
+
+func TestPortfolioUseCase_RecordTrade_InsufficientHoldings(t *testing.T) {
+	portfolioRepo := new(MockPortfolioRepository)
+	useCase := NewPortfolioUseCase(portfolioRepo, new(MockCurrencyRepository), testQuotes)
+	held := []*entities.Transaction{
+		{CurrencyID: "bitcoin", Quote: "usd", Side: entities.TradeBuy, Amount: 0.5, Price: 60000},
+	}
+
+	portfolioRepo.On("GetTransactions", mock.Anything, int64(1)).Return(held, nil)
+
+	err := useCase.RecordTrade(context.Background(), &entities.Transaction{
+		UserID: 1, CurrencyID: "bitcoin", Quote: "usd", Side: entities.TradeSell, Amount: 0.6, Price: 65000,
+	})
+
+	assert.ErrorIs(t, err, entities.ErrInsufficientHoldings)
+	portfolioRepo.AssertNotCalled(t, "AddTransaction", mock.Anything, mock.Anything)
+}
+
+func TestPortfolioUseCase_RecordTrade_SellInHoldingQuote(t *testing.T) {
+	portfolioRepo := new(MockPortfolioRepository)
+	currencyRepo := new(MockCurrencyRepository)
+	useCase := NewPortfolioUseCase(portfolioRepo, currencyRepo, testQuotes)
+	held := []*entities.Transaction{
+		{CurrencyID: "bitcoin", Quote: "usd", Side: entities.TradeBuy, Amount: 0.5, Price: 60000},
+	}
+	transaction := &entities.Transaction{UserID: 1, CurrencyID: "bitcoin", Quote: "eur", Side: entities.TradeSell, Amount: 0.5, Price: 54000}
+
+	portfolioRepo.On("GetTransactions", mock.Anything, int64(1)).Return(held, nil)
+	currencyRepo.On("GetLatestByCurrency", mock.Anything, "bitcoin", "eur").Return(&entities.CurrencyRate{CurrentPrice: 60000}, nil)
+	currencyRepo.On("GetLatestByCurrency", mock.Anything, "bitcoin", "usd").Return(&entities.CurrencyRate{CurrentPrice: 66000}, nil)
+	portfolioRepo.On("AddTransaction", mock.Anything, transaction).Return(nil)
+
+	err := useCase.RecordTrade(context.Background(), transaction)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "usd", transaction.Quote)
+	assert.InDelta(t, 59400.0, transaction.Price, 1e-9)
+	portfolioRepo.AssertExpectations(t)
+}
+
+func TestPortfolioUseCase_RecordTrade_Invalid(t *testing.T) {
+	useCase := NewPortfolioUseCase(new(MockPortfolioRepository), new(MockCurrencyRepository), testQuotes)
+
+	err := useCase.RecordTrade(context.Background(), &entities.Transaction{
+		CurrencyID: "bitcoin", Quote: "usd", Side: entities.TradeBuy, Amount: -1,
+	})
+
+	assert.ErrorIs(t, err, entities.ErrInvalidArgument)
+}
+
+func TestPortfolioUseCase_GetPortfolio(t *testing.T) {
+	portfolioRepo := new(MockPortfolioRepository)
+	currencyRepo := new(MockCurrencyRepository)
+	useCase := NewPortfolioUseCase(portfolioRepo, currencyRepo, testQuotes)
+	transactions := []*entities.Transaction{
+		{CurrencyID: "bitcoin", Quote: "usd", Side: entities.TradeBuy, Amount: 1, Price: 60000},
+		{CurrencyID: "bitcoin", Quote: "usd", Side: entities.TradeBuy, Amount: 1, Price: 70000},
+		{CurrencyID: "bitcoin", Quote: "usd", Side: entities.TradeSell, Amount: 1, Price: 80000},
+		{CurrencyID: "dogecoin", Quote: "usd", Side: entities.TradeBuy, Amount: 100, Price: 0.1},
+	}
+
+	portfolioRepo.On("GetTransactions", mock.Anything, int64(1)).Return(transactions, nil)
+	currencyRepo.On("GetRates", mock.Anything, "usd").Return([]*entities.CurrencyRate{
+		{CurrencyID: "bitcoin", CurrentPrice: 75000},
+	}, nil).Once()
+
+	portfolio, err := useCase.GetPortfolio(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Len(t, portfolio.Positions, 2)
+
+	bitcoin := portfolio.Positions[0]
+	assert.Equal(t, 1.0, bitcoin.Amount)
+	assert.Equal(t, 65000.0, bitcoin.CostBasis)
+	assert.Equal(t, 65000.0, bitcoin.AveragePrice)
+	assert.Equal(t, 15000.0, bitcoin.RealizedPnL)
+	assert.Equal(t, 10000.0, bitcoin.UnrealizedPnL)
+	assert.True(t, bitcoin.Priced)
+
+	assert.False(t, portfolio.Positions[1].Priced)
+	assert.Len(t, portfolio.Totals, 1)
+	assert.Equal(t, 75000.0, portfolio.Totals[0].Value)
+	assert.InDelta(t, 65010.0, portfolio.Totals[0].CostBasis, 1e-9)
+	currencyRepo.AssertExpectations(t)
+}
+
+func TestPortfolioUseCase_Token(t *testing.T) {
+	portfolioRepo := new(MockPortfolioRepository)
+	useCase := NewPortfolioUseCase(portfolioRepo, new(MockCurrencyRepository), testQuotes)
+
+	var saved string
+	portfolioRepo.On("SaveTokenHash", mock.Anything, int64(1), mock.Anything).
+		Run(func(args mock.Arguments) { saved = args.String(2) }).Return(nil)
+
+	token, err := useCase.IssueToken(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Len(t, token, 64)
+	assert.NotEqual(t, token, saved)
+
+	portfolioRepo.On("GetUserByTokenHash", mock.Anything, saved).Return(int64(1), nil)
+	userID, err := useCase.Authenticate(context.Background(), token)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), userID)
+
+	_, err = useCase.Authenticate(context.Background(), "")
+	assert.ErrorIs(t, err, entities.ErrInvalidToken)
+}
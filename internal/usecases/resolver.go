@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"context"
+	"currencyhub/internal/entities"
+	"errors"
+	"sort"
+	"strings"
+)
+
+// maxSuggestions limits number of coins offered for unresolved query
+const maxSuggestions = 3
+
+// Resolve maps coin identifier, ticker, name or alias to tracked catalog coin
+// Returns CoinNotFoundError with closest matches when nothing matches exactly
+func (uc *CoinUseCase) Resolve(ctx context.Context, query string) (*entities.Coin, error) {
+	coins, err := uc.coinRepo.GetCoins(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	aliases, err := uc.coinRepo.GetAliases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolve(coins, aliases, query)
+}
+
+// Search finds tracked coins matching query for autocomplete
+// Exact match goes first, then prefix matches and closest suggestions, empty query lists catalog
+func (uc *CoinUseCase) Search(ctx context.Context, query string, limit int) ([]*entities.Coin, error) {
+	coins, err := uc.coinRepo.GetCoins(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	aliases, err := uc.coinRepo.GetAliases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return search(coins, aliases, query, limit), nil
+}
+
+// search collects up to limit coins matching query without duplicates
+func search(coins []*entities.Coin, aliases map[string]string, query string, limit int) []*entities.Coin {
+	key := normalizeCoinKey(query)
+	if key == "" {
+		return coins[:min(limit, len(coins))]
+	}
+
+	byID := make(map[string]*entities.Coin, len(coins))
+	for _, coin := range coins {
+		byID[coin.ID] = coin
+	}
+
+	found := make([]*entities.Coin, 0, limit)
+	seen := make(map[string]bool)
+	add := func(coin *entities.Coin) {
+		if coin != nil && !seen[coin.ID] && len(found) < limit {
+			seen[coin.ID] = true
+			found = append(found, coin)
+		}
+	}
+
+	coin, err := resolve(coins, aliases, query)
+	add(coin)
+	for _, coin := range coins {
+		if strings.HasPrefix(coin.ID, key) || strings.HasPrefix(normalizeCoinKey(coin.Symbol), key) ||
+			strings.HasPrefix(normalizeCoinKey(coin.Name), key) {
+			add(coin)
+		}
+	}
+
+	var matched []string
+	for alias, coinID := range aliases {
+		if strings.HasPrefix(alias, key) {
+			matched = append(matched, coinID)
+		}
+	}
+	sort.Strings(matched)
+	for _, coinID := range matched {
+		add(byID[coinID])
+	}
+
+	var notFound *entities.CoinNotFoundError
+	if errors.As(err, &notFound) {
+		for _, coinID := range notFound.Suggestions {
+			add(byID[coinID])
+		}
+	}
+	return found
+}
+
+// resolve looks coin up by identifier, symbol, name and alias in that order
+// Falls back to edit distance suggestions over all these keys
+func resolve(coins []*entities.Coin, aliases map[string]string, query string) (*entities.Coin, error) {
+	key := normalizeCoinKey(query)
+	if key == "" {
+		return nil, &entities.CoinNotFoundError{Query: query}
+	}
+
+	byID := make(map[string]*entities.Coin, len(coins))
+	for _, coin := range coins {
+		byID[coin.ID] = coin
+	}
+
+	if coin, ok := byID[key]; ok {
+		return coin, nil
+	}
+	for _, coin := range coins {
+		if normalizeCoinKey(coin.Symbol) == key {
+			return coin, nil
+		}
+	}
+	for _, coin := range coins {
+		if normalizeCoinKey(coin.Name) == key {
+			return coin, nil
+		}
+	}
+	if coin, ok := byID[aliases[key]]; ok {
+		return coin, nil
+	}
+
+	return nil, &entities.CoinNotFoundError{Query: query, Suggestions: suggest(coins, aliases, key)}
+}
+
+// suggest returns identifiers of coins whose keys are closest to query
+// Only matches within third of query length are considered similar
+func suggest(coins []*entities.Coin, aliases map[string]string, key string) []string {
+	threshold := max(1, len([]rune(key))/3)
+	best := make(map[string]int)
+	consider := func(coinID, candidate string) {
+		candidate = normalizeCoinKey(candidate)
+		distance := levenshtein(key, candidate)
+		if strings.HasPrefix(candidate, key) && len(key) >= 3 {
+			distance = min(distance, 1)
+		}
+		if distance > threshold {
+			return
+		}
+		if current, ok := best[coinID]; !ok || distance < current {
+			best[coinID] = distance
+		}
+	}
+
+	tracked := make(map[string]bool, len(coins))
+	for _, coin := range coins {
+		tracked[coin.ID] = true
+		consider(coin.ID, coin.ID)
+		consider(coin.ID, coin.Symbol)
+		consider(coin.ID, coin.Name)
+	}
+	for alias, coinID := range aliases {
+		if tracked[coinID] {
+			consider(coinID, alias)
+		}
+	}
+
+	suggestions := make([]string, 0, len(best))
+	for coinID := range best {
+		suggestions = append(suggestions, coinID)
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		if best[suggestions[i]] != best[suggestions[j]] {
+			return best[suggestions[i]] < best[suggestions[j]]
+		}
+		return suggestions[i] < suggestions[j]
+	})
+	if len(suggestions) > maxSuggestions {
+		suggestions = suggestions[:maxSuggestions]
+	}
+	return suggestions
+}
+
+// normalizeCoinKey lowercases lookup key and joins words with dashes
+// so that "Bitcoin Cash" and "bitcoin-cash" compare equal
+func normalizeCoinKey(value string) string {
+	return strings.Join(strings.Fields(strings.ToLower(strings.ReplaceAll(value, "_", " "))), "-")
+}
+
+// levenshtein calculates edit distance between two strings
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
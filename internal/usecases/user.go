@@ -7,23 +7,34 @@ package usecase
 
 import (
 	"context"
+	"currencyhub/internal/entities"
 	"currencyhub/internal/interfaces"
+	"fmt"
 )
 
 // UserUseCase struct represents user entities with business logic
 type UserUseCase struct {
 	userRepo interfaces.UserRepository
+	quotes   entities.QuoteSet
 }
 
 // NewUserUseCase creates a new instance of UserUseCase
-// with the provided user repository dependency
-func NewUserUseCase(userRepo interfaces.UserRepository) *UserUseCase {
-	return &UserUseCase{userRepo: userRepo}
+// with the provided user repository dependency and fetched quote currencies
+func NewUserUseCase(userRepo interfaces.UserRepository, quotes entities.QuoteSet) *UserUseCase {
+	return &UserUseCase{userRepo: userRepo, quotes: quotes}
 }
 
 // GetSubscribedUsers retrieves all users with auto-subscription enabled
-func (uc *UserUseCase) GetSubscribedUsers(ctx context.Context) (map[int64]uint, error) {
-	return uc.userRepo.GetSubscribedUsers(ctx)
+// Users come with update interval, quote currency, language and watchlist
+func (uc *UserUseCase) GetSubscribedUsers(ctx context.Context) ([]*entities.User, error) {
+	users, err := uc.userRepo.GetSubscribedUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		user.Quote = uc.fetchedQuote(user.Quote)
+	}
+	return users, nil
 }
 
 // SetAutoSubscribe enables automatic updates for a user
@@ -40,3 +51,93 @@ func (uc *UserUseCase) DisableAutoSubscribe(ctx context.Context, userID int64) e
 func (uc *UserUseCase) GetUserSendInterval(ctx context.Context, userID int64) (uint, error) {
 	return uc.userRepo.GetUserSendInterval(ctx, userID)
 }
+
+// GetUserQuote returns quote currency user wants prices in
+// Default quote is returned, together with error when preference cannot be read, for quotes no longer fetched
+func (uc *UserUseCase) GetUserQuote(ctx context.Context, userID int64) (string, error) {
+	quote, err := uc.userRepo.GetUserQuote(ctx, userID)
+	if err != nil {
+		return uc.quotes.Default(), err
+	}
+	return uc.fetchedQuote(quote), nil
+}
+
+// SetUserQuote changes quote currency user wants prices in
+// Returns ErrUnsupportedQuote for quote currencies prices are not fetched in
+func (uc *UserUseCase) SetUserQuote(ctx context.Context, userID int64, quote string) error {
+	currency, ok := uc.quotes.Lookup(quote)
+	if !ok {
+		return fmt.Errorf("%w: %s", entities.ErrUnsupportedQuote, quote)
+	}
+	return uc.userRepo.SetUserQuote(ctx, userID, currency.Code)
+}
+
+// Quotes returns codes of quote currencies user may choose from
+func (uc *UserUseCase) Quotes() []string {
+	return uc.quotes.Codes()
+}
+
+// fetchedQuote returns stored quote when its prices are fetched, default quote otherwise
+func (uc *UserUseCase) fetchedQuote(quote string) string {
+	if currency, ok := uc.quotes.Lookup(quote); ok {
+		return currency.Code
+	}
+	return uc.quotes.Default()
+}
+
+// GetUserLanguage returns interface language of user
+// Language chosen with SetUserLanguage wins, otherwise it follows Telegram client and is stored for notifications
+// Returns detected language together with error when stored language cannot be read or saved
+func (uc *UserUseCase) GetUserLanguage(ctx context.Context, userID int64, clientLanguage string) (string, error) {
+	detected, ok := entities.LookupLanguage(clientLanguage)
+	if !ok {
+		detected = entities.DefaultLanguage
+	}
+
+	language, chosen, err := uc.userRepo.GetUserLanguage(ctx, userID)
+	if err != nil {
+		return detected, err
+	}
+	if chosen || (clientLanguage == "" && language != "") {
+		return language, nil
+	}
+	if clientLanguage == "" || language == detected {
+		return detected, nil
+	}
+	return detected, uc.userRepo.SetUserLanguage(ctx, userID, detected, false)
+}
+
+// SetUserLanguage changes interface language of user
+// Empty language resets choice so that language follows Telegram client again
+func (uc *UserUseCase) SetUserLanguage(ctx context.Context, userID int64, language string) error {
+	if language == "" {
+		return uc.userRepo.SetUserLanguage(ctx, userID, "", false)
+	}
+	code, ok := entities.LookupLanguage(language)
+	if !ok {
+		return &entities.ValidationError{Field: "language", Message: fmt.Sprintf("unsupported language: %s", language)}
+	}
+	return uc.userRepo.SetUserLanguage(ctx, userID, code, true)
+}
+
+// GetWatchlist returns coins user follows, empty when user follows all coins
+func (uc *UserUseCase) GetWatchlist(ctx context.Context, userID int64) ([]string, error) {
+	return uc.userRepo.GetWatchlist(ctx, userID)
+}
+
+// Watch adds catalog coins to user's watchlist
+func (uc *UserUseCase) Watch(ctx context.Context, userID int64, coinIDs []string) error {
+	if len(coinIDs) == 0 {
+		return &entities.ValidationError{Field: "coins", Message: "no coins to watch"}
+	}
+	return uc.userRepo.AddToWatchlist(ctx, userID, coinIDs)
+}
+
+// Unwatch removes coins from user's watchlist
+// Clears whole watchlist when no coins are given
+func (uc *UserUseCase) Unwatch(ctx context.Context, userID int64, coinIDs []string) error {
+	if len(coinIDs) == 0 {
+		return uc.userRepo.ClearWatchlist(ctx, userID)
+	}
+	return uc.userRepo.RemoveFromWatchlist(ctx, userID, coinIDs)
+}
@@ -2,19 +2,23 @@ package usecase
 
 import (
 	"context"
+	"currencyhub/internal/entities"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
+// testQuotes are quote currencies fetched in tests
+var testQuotes, _ = entities.NewQuoteSet([]string{"usd", "eur", "rub", "btc"})
+
 type MockUserRepository struct {
 	mock.Mock
 }
 
-func (m *MockUserRepository) GetSubscribedUsers(ctx context.Context) (map[int64]uint, error) {
+func (m *MockUserRepository) GetSubscribedUsers(ctx context.Context) ([]*entities.User, error) {
 	args := m.Called(ctx)
-	return args.Get(0).(map[int64]uint), args.Error(1)
+	return args.Get(0).([]*entities.User), args.Error(1)
 }
 
 func (m *MockUserRepository) SetAutoSubscribe(ctx context.Context, userID int64, interval uint) error {
@@ -32,9 +36,49 @@ func (m *MockUserRepository) GetUserSendInterval(ctx context.Context, userID int
 	return args.Get(0).(uint), args.Error(1)
 }
 
+func (m *MockUserRepository) GetUserQuote(ctx context.Context, userID int64) (string, error) {
+	args := m.Called(ctx, userID)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockUserRepository) SetUserQuote(ctx context.Context, userID int64, quote string) error {
+	args := m.Called(ctx, userID, quote)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) GetUserLanguage(ctx context.Context, userID int64) (string, bool, error) {
+	args := m.Called(ctx, userID)
+	return args.String(0), args.Bool(1), args.Error(2)
+}
+
+func (m *MockUserRepository) SetUserLanguage(ctx context.Context, userID int64, language string, chosen bool) error {
+	args := m.Called(ctx, userID, language, chosen)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) GetWatchlist(ctx context.Context, userID int64) ([]string, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockUserRepository) AddToWatchlist(ctx context.Context, userID int64, coinIDs []string) error {
+	args := m.Called(ctx, userID, coinIDs)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) RemoveFromWatchlist(ctx context.Context, userID int64, coinIDs []string) error {
+	args := m.Called(ctx, userID, coinIDs)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) ClearWatchlist(ctx context.Context, userID int64) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
 func TestUserUseCase_SetAutoSubscribe(t *testing.T) {
 	mockRepo := new(MockUserRepository)
-	useCase := NewUserUseCase(mockRepo)
+	useCase := NewUserUseCase(mockRepo, testQuotes)
 
 	mockRepo.On("SetAutoSubscribe", mock.Anything, int64(123), uint(10)).Return(nil)
 
@@ -46,7 +90,7 @@ func TestUserUseCase_SetAutoSubscribe(t *testing.T) {
 
 func TestUserUseCase_DisableAutoSubscribe(t *testing.T) {
 	mockRepo := new(MockUserRepository)
-	useCase := NewUserUseCase(mockRepo)
+	useCase := NewUserUseCase(mockRepo, testQuotes)
 
 	mockRepo.On("DisableAutoSubscribe", mock.Anything, int64(123)).Return(nil)
 
@@ -58,9 +102,12 @@ func TestUserUseCase_DisableAutoSubscribe(t *testing.T) {
 
 func TestUserUseCase_GetSubscribedUsers(t *testing.T) {
 	mockRepo := new(MockUserRepository)
-	useCase := NewUserUseCase(mockRepo)
+	useCase := NewUserUseCase(mockRepo, testQuotes)
 
-	expectedUsers := map[int64]uint{123: 10, 456: 15}
+	expectedUsers := []*entities.User{
+		{TelegramID: 123, AutoSubscribe: true, SendInterval: 10, Quote: "usd"},
+		{TelegramID: 456, AutoSubscribe: true, SendInterval: 15, Quote: "eur", Watchlist: []string{"bitcoin", "solana"}},
+	}
 	mockRepo.On("GetSubscribedUsers", mock.Anything).Return(expectedUsers, nil)
 
 	users, err := useCase.GetSubscribedUsers(context.Background())
@@ -69,3 +116,81 @@ func TestUserUseCase_GetSubscribedUsers(t *testing.T) {
 	assert.Equal(t, expectedUsers, users)
 	mockRepo.AssertExpectations(t)
 }
+
+func TestUserUseCase_SetUserQuote(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	useCase := NewUserUseCase(mockRepo, testQuotes)
+
+	mockRepo.On("SetUserQuote", mock.Anything, int64(123), "rub").Return(nil)
+
+	assert.NoError(t, useCase.SetUserQuote(context.Background(), 123, "RUB"))
+	assert.Error(t, useCase.SetUserQuote(context.Background(), 123, "xyz"))
+	assert.ErrorIs(t, useCase.SetUserQuote(context.Background(), 123, "gbp"), entities.ErrUnsupportedQuote)
+	mockRepo.AssertNumberOfCalls(t, "SetUserQuote", 1)
+}
+
+func TestUserUseCase_GetUserQuote(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	useCase := NewUserUseCase(mockRepo, testQuotes)
+
+	mockRepo.On("GetUserQuote", mock.Anything, int64(1)).Return("eur", nil)
+	mockRepo.On("GetUserQuote", mock.Anything, int64(2)).Return("gbp", nil)
+
+	quote, err := useCase.GetUserQuote(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "eur", quote)
+
+	quote, err = useCase.GetUserQuote(context.Background(), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "usd", quote)
+}
+
+func TestUserUseCase_GetUserLanguage(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	useCase := NewUserUseCase(mockRepo, testQuotes)
+
+	mockRepo.On("GetUserLanguage", mock.Anything, int64(1)).Return("en", false, nil)
+	mockRepo.On("GetUserLanguage", mock.Anything, int64(2)).Return("en", true, nil)
+	mockRepo.On("SetUserLanguage", mock.Anything, int64(1), "ru", false).Return(nil)
+
+	language, err := useCase.GetUserLanguage(context.Background(), 1, "ru-RU")
+	assert.NoError(t, err)
+	assert.Equal(t, "ru", language)
+
+	language, err = useCase.GetUserLanguage(context.Background(), 1, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "en", language)
+
+	language, err = useCase.GetUserLanguage(context.Background(), 2, "ru")
+	assert.NoError(t, err)
+	assert.Equal(t, "en", language)
+	mockRepo.AssertNumberOfCalls(t, "SetUserLanguage", 1)
+}
+
+func TestUserUseCase_SetUserLanguage(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	useCase := NewUserUseCase(mockRepo, testQuotes)
+
+	mockRepo.On("SetUserLanguage", mock.Anything, int64(123), "ru", true).Return(nil)
+	mockRepo.On("SetUserLanguage", mock.Anything, int64(123), "", false).Return(nil)
+
+	assert.NoError(t, useCase.SetUserLanguage(context.Background(), 123, "RU"))
+	assert.NoError(t, useCase.SetUserLanguage(context.Background(), 123, ""))
+	assert.ErrorIs(t, useCase.SetUserLanguage(context.Background(), 123, "xx"), entities.ErrInvalidArgument)
+	mockRepo.AssertNumberOfCalls(t, "SetUserLanguage", 2)
+}
+
+func TestUserUseCase_Watchlist(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	useCase := NewUserUseCase(mockRepo, testQuotes)
+
+	mockRepo.On("AddToWatchlist", mock.Anything, int64(123), []string{"bitcoin", "solana"}).Return(nil)
+	mockRepo.On("RemoveFromWatchlist", mock.Anything, int64(123), []string{"solana"}).Return(nil)
+	mockRepo.On("ClearWatchlist", mock.Anything, int64(123)).Return(nil)
+
+	assert.NoError(t, useCase.Watch(context.Background(), 123, []string{"bitcoin", "solana"}))
+	assert.ErrorIs(t, useCase.Watch(context.Background(), 123, nil), entities.ErrInvalidArgument)
+	assert.NoError(t, useCase.Unwatch(context.Background(), 123, []string{"solana"}))
+	assert.NoError(t, useCase.Unwatch(context.Background(), 123, nil))
+	mockRepo.AssertExpectations(t)
+}
@@ -1,5 +1,4 @@
-
 DROP INDEX IF EXISTS idx_currencies_timestamp;
 DROP INDEX IF EXISTS idx_currencies_currency_id;
 DROP TABLE IF EXISTS currencies;
-DROP TABLE IF EXISTS users;
\ No newline at end of file
+DROP TABLE IF EXISTS users;
@@ -1,4 +1,4 @@
-
+-- Создание таблицы текущих курсов
 CREATE TABLE IF NOT EXISTS currencies (
                                           currency_id TEXT PRIMARY KEY,
                                           current_price DECIMAL NOT NULL,
@@ -20,4 +20,4 @@ CREATE TABLE IF NOT EXISTS users (
 
 -- Создание индексов для улучшения производительности
 CREATE INDEX IF NOT EXISTS idx_currencies_timestamp ON currencies(time_stamp);
-CREATE INDEX IF NOT EXISTS idx_currencies_currency_id ON currencies(currency_id);
\ No newline at end of file
+CREATE INDEX IF NOT EXISTS idx_currencies_currency_id ON currencies(currency_id);
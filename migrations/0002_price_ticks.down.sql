@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_price_ticks_currency_observed;
+DROP TABLE IF EXISTS price_ticks;
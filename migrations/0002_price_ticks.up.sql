@@ -0,0 +1,16 @@
+-- Создание таблицы истории цен
+CREATE TABLE IF NOT EXISTS price_ticks (
+                                           id BIGSERIAL PRIMARY KEY,
+                                           currency_id TEXT NOT NULL,
+                                           price DECIMAL NOT NULL,
+                                           observed_at TIMESTAMP NOT NULL,
+                                           source TEXT NOT NULL DEFAULT 'unknown'
+);
+
+CREATE INDEX IF NOT EXISTS idx_price_ticks_currency_observed ON price_ticks(currency_id, observed_at);
+
+-- Перенос существующих снимков в историю цен
+INSERT INTO price_ticks (currency_id, price, observed_at, source)
+SELECT c.currency_id, c.current_price, c.time_stamp, 'snapshot'
+FROM currencies c
+WHERE NOT EXISTS (SELECT 1 FROM price_ticks p WHERE p.currency_id = c.currency_id);
@@ -0,0 +1,27 @@
+-- Создание таблицы свечей (OHLC) по интервалам
+CREATE TABLE IF NOT EXISTS candles (
+                                       currency_id TEXT NOT NULL,
+                                       resolution TEXT NOT NULL,
+                                       open_time TIMESTAMP NOT NULL,
+                                       open_price DECIMAL NOT NULL,
+                                       high_price DECIMAL NOT NULL,
+                                       low_price DECIMAL NOT NULL,
+                                       close_price DECIMAL NOT NULL,
+                                       ticks BIGINT NOT NULL DEFAULT 0,
+                                       PRIMARY KEY (currency_id, resolution, open_time)
+);
+
+-- Свертка накопленной истории цен в свечи каждого интервала
+INSERT INTO candles (currency_id, resolution, open_time, open_price, high_price, low_price, close_price, ticks)
+SELECT p.currency_id,
+       r.resolution,
+       TIMESTAMP 'epoch' + floor(extract(EPOCH FROM p.observed_at) / r.seconds) * r.seconds * INTERVAL '1 second' AS open_time,
+       (array_agg(p.price ORDER BY p.observed_at, p.id))[1],
+       max(p.price),
+       min(p.price),
+       (array_agg(p.price ORDER BY p.observed_at DESC, p.id DESC))[1],
+       count(*)
+FROM price_ticks p
+         CROSS JOIN (VALUES ('1m', 60), ('5m', 300), ('1h', 3600), ('1d', 86400)) AS r(resolution, seconds)
+GROUP BY p.currency_id, r.resolution, 3
+ON CONFLICT (currency_id, resolution, open_time) DO NOTHING;
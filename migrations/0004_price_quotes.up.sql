@@ -0,0 +1,10 @@
+-- Создание таблицы котировок источников для агрегированных цен
+CREATE TABLE IF NOT EXISTS price_quotes (
+                                            tick_id BIGINT NOT NULL REFERENCES price_ticks(id) ON DELETE CASCADE,
+                                            source TEXT NOT NULL,
+                                            price DECIMAL NOT NULL,
+                                            volume DECIMAL NOT NULL DEFAULT 0,
+                                            deviation_percent DECIMAL NOT NULL DEFAULT 0,
+                                            excluded BOOLEAN NOT NULL DEFAULT FALSE,
+                                            PRIMARY KEY (tick_id, source)
+);
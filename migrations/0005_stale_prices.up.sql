@@ -0,0 +1,2 @@
+-- Признак устаревшей цены, не полученной в последнем цикле обновления
+ALTER TABLE currencies ADD COLUMN IF NOT EXISTS stale BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,16 @@
+-- Оставляются только котировки в долларах, чтобы вернуть прежние ключи
+ALTER TABLE users DROP COLUMN IF EXISTS quote;
+
+DROP INDEX IF EXISTS idx_candles_currency_quote;
+DELETE FROM candles WHERE quote <> 'usd';
+ALTER TABLE candles DROP COLUMN IF EXISTS quote;
+ALTER TABLE candles ADD PRIMARY KEY (currency_id, resolution, open_time);
+
+DROP INDEX IF EXISTS idx_price_ticks_currency_quote_observed;
+DELETE FROM price_ticks WHERE quote <> 'usd';
+ALTER TABLE price_ticks DROP COLUMN IF EXISTS quote;
+
+DROP INDEX IF EXISTS idx_currencies_currency_quote;
+DELETE FROM currencies WHERE quote <> 'usd';
+ALTER TABLE currencies DROP COLUMN IF EXISTS quote;
+ALTER TABLE currencies ADD PRIMARY KEY (currency_id);
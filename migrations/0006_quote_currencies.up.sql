@@ -0,0 +1,14 @@
+-- Валюта котировки цен, существующие данные считаются котировками в долларах
+ALTER TABLE currencies ADD COLUMN IF NOT EXISTS quote TEXT NOT NULL DEFAULT 'usd';
+ALTER TABLE currencies DROP CONSTRAINT IF EXISTS currencies_pkey;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_currencies_currency_quote ON currencies(currency_id, quote);
+
+ALTER TABLE price_ticks ADD COLUMN IF NOT EXISTS quote TEXT NOT NULL DEFAULT 'usd';
+CREATE INDEX IF NOT EXISTS idx_price_ticks_currency_quote_observed ON price_ticks(currency_id, quote, observed_at);
+
+ALTER TABLE candles ADD COLUMN IF NOT EXISTS quote TEXT NOT NULL DEFAULT 'usd';
+ALTER TABLE candles DROP CONSTRAINT IF EXISTS candles_pkey;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_candles_currency_quote ON candles(currency_id, quote, resolution, open_time);
+
+-- Предпочитаемая пользователем валюта котировки
+ALTER TABLE users ADD COLUMN IF NOT EXISTS quote TEXT NOT NULL DEFAULT 'usd';
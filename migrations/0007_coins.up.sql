@@ -0,0 +1,35 @@
+-- Создание каталога монет, заполняется ранее зашитым списком
+CREATE TABLE IF NOT EXISTS coins (
+                                     id TEXT PRIMARY KEY,
+                                     symbol TEXT NOT NULL,
+                                     name TEXT NOT NULL,
+                                     enabled BOOLEAN NOT NULL DEFAULT TRUE,
+                                     added_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Порядок добавления сохраняет прежний порядок списка
+INSERT INTO coins (id, symbol, name, added_at)
+SELECT id, symbol, name, NOW() + pos * INTERVAL '1 millisecond'
+FROM (VALUES
+    ('bitcoin', 'BTC', 'Bitcoin', 1),
+    ('ethereum', 'ETH', 'Ethereum', 2),
+    ('tether', 'USDT', 'Tether', 3),
+    ('binancecoin', 'BNB', 'BNB', 4),
+    ('solana', 'SOL', 'Solana', 5),
+    ('usd-coin', 'USDC', 'USD Coin', 6),
+    ('ripple', 'XRP', 'XRP', 7),
+    ('the-open-network', 'TON', 'Toncoin', 8),
+    ('dogecoin', 'DOGE', 'Dogecoin', 9),
+    ('cardano', 'ADA', 'Cardano', 10),
+    ('shiba-inu', 'SHIB', 'Shiba Inu', 11),
+    ('avalanche-2', 'AVAX', 'Avalanche', 12),
+    ('polkadot', 'DOT', 'Polkadot', 13),
+    ('tron', 'TRX', 'TRON', 14),
+    ('chainlink', 'LINK', 'Chainlink', 15),
+    ('polygon-pos', 'POL', 'Polygon', 16),
+    ('bitcoin-cash', 'BCH', 'Bitcoin Cash', 17),
+    ('litecoin', 'LTC', 'Litecoin', 18),
+    ('uniswap', 'UNI', 'Uniswap', 19),
+    ('dai', 'DAI', 'Dai', 20)
+) AS seed(id, symbol, name, pos)
+ON CONFLICT (id) DO NOTHING;
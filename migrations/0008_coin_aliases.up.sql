@@ -0,0 +1,22 @@
+-- Псевдонимы монет для поиска по распространенным названиям
+CREATE TABLE IF NOT EXISTS coin_aliases (
+                                            alias TEXT PRIMARY KEY,
+                                            coin_id TEXT NOT NULL REFERENCES coins(id) ON DELETE CASCADE
+);
+
+INSERT INTO coin_aliases (alias, coin_id) VALUES
+    ('xbt', 'bitcoin'),
+    ('ether', 'ethereum'),
+    ('binance-coin', 'binancecoin'),
+    ('bnb-coin', 'binancecoin'),
+    ('usdc', 'usd-coin'),
+    ('ton', 'the-open-network'),
+    ('avalanche', 'avalanche-2'),
+    ('matic', 'polygon-pos'),
+    ('polygon-matic', 'polygon-pos'),
+    ('bch', 'bitcoin-cash'),
+    ('shib', 'shiba-inu'),
+    ('link', 'chainlink'),
+    ('trx', 'tron'),
+    ('uni', 'uniswap')
+ON CONFLICT (alias) DO NOTHING;
@@ -0,0 +1,15 @@
+-- Пользовательские алерты на пересечение ценой порога
+CREATE TABLE IF NOT EXISTS price_alerts (
+                                            id BIGSERIAL PRIMARY KEY,
+                                            telegram_id BIGINT NOT NULL,
+                                            currency_id TEXT NOT NULL REFERENCES coins(id) ON DELETE CASCADE,
+                                            quote TEXT NOT NULL,
+                                            condition TEXT NOT NULL CHECK (condition IN ('above', 'below')),
+                                            threshold DECIMAL NOT NULL,
+                                            created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+                                            triggered_at TIMESTAMP
+);
+
+-- Проверка цены выбирает только активные алерты монеты
+CREATE INDEX IF NOT EXISTS idx_price_alerts_active ON price_alerts(currency_id, quote) WHERE triggered_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_price_alerts_user ON price_alerts(telegram_id);
@@ -0,0 +1,9 @@
+DELETE FROM price_alerts WHERE condition IN ('rise', 'fall', 'move');
+
+ALTER TABLE price_alerts DROP COLUMN IF EXISTS last_fired_at;
+ALTER TABLE price_alerts DROP COLUMN IF EXISTS cooldown_seconds;
+ALTER TABLE price_alerts DROP COLUMN IF EXISTS window_seconds;
+
+ALTER TABLE price_alerts DROP CONSTRAINT IF EXISTS price_alerts_condition_check;
+ALTER TABLE price_alerts ADD CONSTRAINT price_alerts_condition_check
+    CHECK (condition IN ('above', 'below'));
@@ -0,0 +1,8 @@
+-- Алерты на изменение цены в процентах за окно времени с паузой между срабатываниями
+ALTER TABLE price_alerts DROP CONSTRAINT IF EXISTS price_alerts_condition_check;
+ALTER TABLE price_alerts ADD CONSTRAINT price_alerts_condition_check
+    CHECK (condition IN ('above', 'below', 'rise', 'fall', 'move'));
+
+ALTER TABLE price_alerts ADD COLUMN IF NOT EXISTS window_seconds INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE price_alerts ADD COLUMN IF NOT EXISTS cooldown_seconds INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE price_alerts ADD COLUMN IF NOT EXISTS last_fired_at TIMESTAMP;
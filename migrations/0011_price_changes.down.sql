@@ -0,0 +1,2 @@
+ALTER TABLE currencies DROP COLUMN IF EXISTS change_7d;
+ALTER TABLE currencies DROP COLUMN IF EXISTS change_24h;
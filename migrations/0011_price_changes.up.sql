@@ -0,0 +1,18 @@
+-- Изменение цены со знаком относительно цены час, сутки и неделю назад
+-- change_percent раньше хранил размах цены за час и пересчитывается как изменение за час
+ALTER TABLE currencies ADD COLUMN IF NOT EXISTS change_24h DECIMAL NOT NULL DEFAULT 0;
+ALTER TABLE currencies ADD COLUMN IF NOT EXISTS change_7d DECIMAL NOT NULL DEFAULT 0;
+
+UPDATE currencies c SET
+    change_percent = COALESCE((
+        SELECT (c.current_price - p.price) / NULLIF(p.price, 0) * 100 FROM price_ticks p
+        WHERE p.currency_id = c.currency_id AND p.quote = c.quote AND p.observed_at <= c.time_stamp - INTERVAL '1 hour'
+        ORDER BY p.observed_at DESC, p.id DESC LIMIT 1), 0),
+    change_24h = COALESCE((
+        SELECT (c.current_price - p.price) / NULLIF(p.price, 0) * 100 FROM price_ticks p
+        WHERE p.currency_id = c.currency_id AND p.quote = c.quote AND p.observed_at <= c.time_stamp - INTERVAL '24 hours'
+        ORDER BY p.observed_at DESC, p.id DESC LIMIT 1), 0),
+    change_7d = COALESCE((
+        SELECT (c.current_price - p.price) / NULLIF(p.price, 0) * 100 FROM price_ticks p
+        WHERE p.currency_id = c.currency_id AND p.quote = c.quote AND p.observed_at <= c.time_stamp - INTERVAL '7 days'
+        ORDER BY p.observed_at DESC, p.id DESC LIMIT 1), 0);
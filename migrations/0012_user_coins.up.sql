@@ -0,0 +1,7 @@
+-- Список наблюдения пользователя: монеты в /rates и автообновлениях
+CREATE TABLE IF NOT EXISTS user_coins (
+                                          telegram_id BIGINT NOT NULL,
+                                          coin_id TEXT NOT NULL REFERENCES coins(id) ON DELETE CASCADE,
+                                          added_at TIMESTAMP NOT NULL DEFAULT NOW(),
+                                          PRIMARY KEY (telegram_id, coin_id)
+);
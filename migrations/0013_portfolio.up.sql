@@ -0,0 +1,20 @@
+-- Сделки портфеля пользователя, цена за монету в валюте котировки сделки
+CREATE TABLE IF NOT EXISTS portfolio_transactions (
+                                                      id BIGSERIAL PRIMARY KEY,
+                                                      telegram_id BIGINT NOT NULL,
+                                                      currency_id TEXT NOT NULL REFERENCES coins(id),
+                                                      quote TEXT NOT NULL,
+                                                      side TEXT NOT NULL CHECK (side IN ('buy', 'sell')),
+                                                      amount DECIMAL NOT NULL CHECK (amount > 0),
+                                                      price DECIMAL NOT NULL CHECK (price >= 0),
+                                                      executed_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_portfolio_transactions_user ON portfolio_transactions(telegram_id, executed_at);
+
+-- Токены доступа к портфелю через REST API, хранится только SHA-256 хеш
+CREATE TABLE IF NOT EXISTS api_tokens (
+                                          token_hash TEXT PRIMARY KEY,
+                                          telegram_id BIGINT NOT NULL UNIQUE,
+                                          created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
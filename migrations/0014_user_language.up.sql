@@ -0,0 +1,6 @@
+-- Язык интерфейса бота: выбранный командой /lang или определенный по языку клиента Telegram
+ALTER TABLE users ADD COLUMN IF NOT EXISTS language TEXT NOT NULL DEFAULT '';
+-- Выбранный язык не меняется при смене языка клиента
+ALTER TABLE users ADD COLUMN IF NOT EXISTS language_chosen BOOLEAN NOT NULL DEFAULT false;
+-- Существующие пользователи получали ответы бота на русском до первого сообщения с языком клиента
+UPDATE users SET language = 'ru' WHERE language = '';
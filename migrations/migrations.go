@@ -0,0 +1,10 @@
+// Package migrations embeds versioned database schema migrations
+// Files are named NNNN_name.up.sql and NNNN_name.down.sql
+package migrations
+
+import "embed"
+
+// FS contains all migration files compiled into binary
+//
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,33 @@
+package monitoring
+
+import (
+	"context"
+	"currencyhub/internal/entities"
+	"strconv"
+)
+
+// ObserveEvents updates metrics from event bus subscriptions
+// Returns when context is cancelled or any channel is closed
+func ObserveEvents(ctx context.Context, prices <-chan entities.PriceUpdated, failures <-chan entities.FetchFailed, coins <-chan entities.CoinAdded) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-prices:
+			if !ok {
+				return
+			}
+			CoinPrice.WithLabelValues(event.Tick.CurrencyID, event.Tick.Quote).Set(event.Tick.Price)
+		case event, ok := <-failures:
+			if !ok {
+				return
+			}
+			FetchFailuresTotal.WithLabelValues(strconv.FormatBool(event.Unavailable)).Inc()
+		case _, ok := <-coins:
+			if !ok {
+				return
+			}
+			CoinsAddedTotal.Inc()
+		}
+	}
+}
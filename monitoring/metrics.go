@@ -22,4 +22,75 @@ var (
 		},
 		[]string{"method", "path"},
 	)
+
+	ProviderCircuitState = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "price_provider_circuit_state",
+			Help: "Circuit breaker state of price provider (0 - closed, 1 - half-open, 2 - open)",
+		},
+		[]string{"provider"},
+	)
+
+	ProviderRequestsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "price_provider_requests_total",
+			Help: "Total number of price provider requests by result",
+		},
+		[]string{"provider", "result"},
+	)
+
+	StreamClients = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "price_stream_clients",
+			Help: "Number of connected price stream clients",
+		},
+		[]string{"transport"},
+	)
+
+	StreamDroppedClients = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "price_stream_dropped_clients_total",
+			Help: "Total number of price stream clients disconnected for not keeping up",
+		},
+		[]string{"transport"},
+	)
+
+	EventsPublished = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "events_published_total",
+			Help: "Total number of events published to event bus",
+		},
+		[]string{"event"},
+	)
+
+	EventsDropped = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "events_dropped_total",
+			Help: "Total number of events dropped for subscribers with full buffer",
+		},
+		[]string{"event", "subscriber"},
+	)
+
+	CoinPrice = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "coin_price",
+			Help: "Latest saved coin price in quote currency",
+		},
+		[]string{"coin", "quote"},
+	)
+
+	FetchFailuresTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "price_fetch_failures_total",
+			Help: "Total number of failed price update cycles",
+		},
+		[]string{"unavailable"},
+	)
+
+	CoinsAddedTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "catalog_coins_added_total",
+			Help: "Total number of coins added to catalog",
+		},
+	)
 )